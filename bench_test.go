@@ -5,6 +5,7 @@ package simd
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,14 @@ func BenchmarkUint8(b *testing.B) {
 			assert.NotZero(b, result)
 		}))
 
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint8sToUint64(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
 		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
 			result := uint8(0)
 			for i := 0; i < b.N; i++ {
@@ -43,6 +52,39 @@ func BenchmarkUint8(b *testing.B) {
 			assert.NotZero(b, result)
 		}))
 
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := uint8(0), uint8(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxUint8s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinUint8s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxUint8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint8(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint8s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
 		output := make([]uint8, count)
 		input1 := makeVector[uint8](count)
 		input2 := makeVector[uint8](count)
@@ -77,6 +119,188 @@ func BenchmarkUint8(b *testing.B) {
 			}
 			assert.NotEmpty(b, result)
 		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = MinOfUint8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = MaxOfUint8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = ClampUint8s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsUint8s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenUint8s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareUint8s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanUint8s(input1, 30, make([]uint64, count/64))
+			var result uint8
+			for i := 0; i < b.N; i++ {
+				result = SumUint8sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanUint8s(input1, 30, make([]uint64, count/64))
+			var result uint8
+			for i := 0; i < b.N; i++ {
+				result, _ = MinUint8sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanUint8s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressUint8s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanUint8s(input1, 30, make([]uint64, count/64))
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = SelectUint8s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = AddScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = SubScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = MulScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = DivScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarUint8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkUint8_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "uint8"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[uint8](count)
+		output := make([]uint8, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := uint8(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := uint8(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxUint8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint8(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint8s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []uint8
+			for i := 0; i < b.N; i++ {
+				result = AddUint8s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
 	}
 
 	// Print out the result and respective speed-up
@@ -92,6 +316,10 @@ func BenchmarkUint8(b *testing.B) {
 // ---------------------------------- Test Uint8 ----------------------------------
 
 func TestUint8_Ops(t *testing.T) {
+	rangeTiers(t, testUint8Ops)
+}
+
+func testUint8Ops(t *testing.T) {
 	{ // Sum
 		input := makeVector[uint8](70)
 		expect := sum(input)
@@ -99,6 +327,14 @@ func TestUint8_Ops(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // Sum (wide)
+		input := makeVector[uint8](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint8sToUint64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Min
 		input := makeVector[uint8](70)
 		expect := min(input)
@@ -113,6 +349,38 @@ func TestUint8_Ops(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // MinMax
+		input := makeVector[uint8](70)
+		lo, hi := MinMaxUint8s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint8](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint8s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint8](1000)
+		expect := argmax(input)
+		result := ArgMaxUint8s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](70)
+		expect := dot(input1, input2)
+		result := DotUint8s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Add
 		input1 := makeVector[uint8](70)
 		input2 := makeVector[uint8](70)
@@ -144,15 +412,94 @@ func TestUint8_Ops(t *testing.T) {
 		result := DivUint8s(make([]uint8, 70), input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
+
+	{ // AddScalar
+		input := makeVector[uint8](70)
+		expect := addScalar(make([]uint8, 70), input, 2)
+		result := AddScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[uint8](70)
+		expect := subScalar(make([]uint8, 70), input, 2)
+		result := SubScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[uint8](70)
+		expect := rsubScalar(make([]uint8, 70), input, 2)
+		result := RevSubScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[uint8](70)
+		expect := mulScalar(make([]uint8, 70), input, 2)
+		result := MulScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[uint8](70)
+		expect := divScalar(make([]uint8, 70), input, 2)
+		result := DivScalarUint8s(make([]uint8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[uint8](70)
+		expect := rdivScalar(make([]uint8, 70), input, 2)
+		result := RevDivScalarUint8s(make([]uint8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](90)[20:]
+		expect := minOf(make([]uint8, 70), input1, input2)
+		result := MinOfUint8s(make([]uint8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](90)[20:]
+		expect := maxOf(make([]uint8, 70), input1, input2)
+		result := MaxOfUint8s(make([]uint8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[uint8](70)
+		expect := minOfScalar(make([]uint8, 70), input, 50)
+		result := MinOfScalarUint8s(make([]uint8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[uint8](70)
+		expect := maxOfScalar(make([]uint8, 70), input, 50)
+		result := MaxOfScalarUint8s(make([]uint8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[uint8](70)
+		expect := clamp(make([]uint8, 70), input, 20, 60)
+		result := ClampUint8s(make([]uint8, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint8, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint8, 70), input, 60, 20), ClampUint8s(make([]uint8, 70), input, 60, 20))
+	}
 }
 
 // ---------------------------------- Test Fallback Uint8 ----------------------------------
 
 func TestUint8_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+	defer SetLevel(Features())
+	SetLevel(Generic)
 
 	{ // Sum
 		input := makeVector[uint8](70)
@@ -161,6 +508,14 @@ func TestUint8_Fallback_Sum(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // Sum (wide)
+		input := makeVector[uint8](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint8sToUint64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Min
 		input := makeVector[uint8](70)
 		expect := min(input)
@@ -175,6 +530,38 @@ func TestUint8_Fallback_Sum(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // MinMax
+		input := makeVector[uint8](70)
+		lo, hi := MinMaxUint8s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint8](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint8s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint8](1000)
+		expect := argmax(input)
+		result := ArgMaxUint8s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](70)
+		expect := dot(input1, input2)
+		result := DotUint8s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Add
 		input1 := makeVector[uint8](70)
 		input2 := makeVector[uint8](70)
@@ -206,465 +593,660 @@ func TestUint8_Fallback_Sum(t *testing.T) {
 		result := DivUint8s(make([]uint8, 70), input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
-}
 
-// ---------------------------------- Benchmark Uint16 ----------------------------------
+	{ // AddScalar
+		input := makeVector[uint8](70)
+		expect := addScalar(make([]uint8, 70), input, 2)
+		result := AddScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
 
-func BenchmarkUint16(b *testing.B) {
-	result := make([]Result, 0, 64)
+	{ // SubScalar
+		input := makeVector[uint8](70)
+		expect := subScalar(make([]uint8, 70), input, 2)
+		result := SubScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
 
-	typ := "uint16"
-	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[uint16](count)
-		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := uint16(0)
-			for i := 0; i < b.N; i++ {
-				result = SumUint16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
+	{ // RevSubScalar
+		input := makeVector[uint8](70)
+		expect := rsubScalar(make([]uint8, 70), input, 2)
+		result := RevSubScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
 
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := uint16(0)
-			for i := 0; i < b.N; i++ {
-				result = MinUint16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := uint16(0)
-			for i := 0; i < b.N; i++ {
-				result = MaxUint16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
-
-		output := make([]uint16, count)
-		input1 := makeVector[uint16](count)
-		input2 := makeVector[uint16](count)
-		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []uint16
-			for i := 0; i < b.N; i++ {
-				result = AddUint16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []uint16
-			for i := 0; i < b.N; i++ {
-				result = SubUint16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []uint16
-			for i := 0; i < b.N; i++ {
-				result = MulUint16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []uint16
-			for i := 0; i < b.N; i++ {
-				result = DivUint16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+	{ // MulScalar
+		input := makeVector[uint8](70)
+		expect := mulScalar(make([]uint8, 70), input, 2)
+		result := MulScalarUint8s(make([]uint8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
 	}
 
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
+	{ // DivScalar
+		input := makeVector[uint8](70)
+		expect := divScalar(make([]uint8, 70), input, 2)
+		result := DivScalarUint8s(make([]uint8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
-}
-
-// ---------------------------------- Test Uint16 ----------------------------------
 
-func TestUint16_Ops(t *testing.T) {
-	{ // Sum
-		input := makeVector[uint16](70)
-		expect := sum(input)
-		result := SumUint16s(input)
-		assert.EqualValues(t, expect, result)
+	{ // RevDivScalar
+		input := makeVector[uint8](70)
+		expect := rdivScalar(make([]uint8, 70), input, 2)
+		result := RevDivScalarUint8s(make([]uint8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
 
-	{ // Min
-		input := makeVector[uint16](70)
-		expect := min(input)
-		result := MinUint16s(input)
+	{ // MinOf
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](90)[20:]
+		expect := minOf(make([]uint8, 70), input1, input2)
+		result := MinOfUint8s(make([]uint8, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Max
-		input := makeVector[uint16](70)
-		expect := max(input)
-		result := MaxUint16s(input)
+	{ // MaxOf
+		input1 := makeVector[uint8](70)
+		input2 := makeVector[uint8](90)[20:]
+		expect := maxOf(make([]uint8, 70), input1, input2)
+		result := MaxOfUint8s(make([]uint8, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := add(make([]uint16, 70), input1, input2)
-		result := AddUint16s(make([]uint16, 70), input1, input2)
+	{ // MinOfScalar
+		input := makeVector[uint8](70)
+		expect := minOfScalar(make([]uint8, 70), input, 50)
+		result := MinOfScalarUint8s(make([]uint8, 70), input, 50)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Sub
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := sub(make([]uint16, 70), input1, input2)
-		result := SubUint16s(make([]uint16, 70), input1, input2)
+	{ // MaxOfScalar
+		input := makeVector[uint8](70)
+		expect := maxOfScalar(make([]uint8, 70), input, 50)
+		result := MaxOfScalarUint8s(make([]uint8, 70), input, 50)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Mul
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := mul(make([]uint16, 70), input1, input2)
-		result := MulUint16s(make([]uint16, 70), input1, input2)
+	{ // Clamp
+		input := makeVector[uint8](70)
+		expect := clamp(make([]uint8, 70), input, 20, 60)
+		result := ClampUint8s(make([]uint8, 70), input, 20, 60)
 		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint8, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint8, 70), input, 60, 20), ClampUint8s(make([]uint8, 70), input, 60, 20))
 	}
+}
 
-	{ // Div
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := div(make([]uint16, 70), input1, input2)
-		result := DivUint16s(make([]uint16, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
-	}
+// ---------------------------------- Test Bounds Uint8 ----------------------------------
+
+func TestUint8_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []uint8{}
+		assert.Zero(t, SumUint8s(nil), mode)
+		assert.Zero(t, SumUint8s(empty), mode)
+		assert.Zero(t, DotUint8s(empty, makeVector[uint8](70)), mode)
+		assert.Zero(t, SumUint8sToUint64(empty), mode)
+		assert.Panics(t, func() { MinUint8s(empty) }, mode)
+		assert.Panics(t, func() { MaxUint8s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxUint8s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinUint8s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxUint8s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[uint8](70), makeVector[uint8](33)
+		assert.EqualValues(t, dot(short, short), DotUint8s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotUint8s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]uint8, n[0]), makeVector[uint8](n[1]), makeVector[uint8](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]uint8, size), input1, input2), AddUint8s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]uint8, size), input1, input2), SubUint8s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]uint8, size), input1, input2), MulUint8s(dst, input1, input2), mode)
+			assert.Len(t, DivUint8s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]uint8, size), input1, input2), MinOfUint8s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]uint8, size), input1, input2), MaxOfUint8s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarUint8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampUint8s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
 }
+// ---------------------------------- Test Compare Uint8 ----------------------------------
 
-// ---------------------------------- Test Fallback Uint16 ----------------------------------
+func TestUint8_Compare(t *testing.T) {
+	rangeTiers(t, testUint8Compare)
+}
 
-func TestUint16_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+func testUint8Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint8](size)
+		words := (size + 63) / 64
+		for _, value := range []uint8{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
 
-	{ // Sum
-		input := makeVector[uint16](70)
-		expect := sum(input)
-		result := SumUint16s(input)
-		assert.EqualValues(t, expect, result)
-	}
+			assert.Equal(t, expect, EqualsUint8s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsUint8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanUint8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanUint8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenUint8s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsUint8s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanUint8s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanUint8s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenUint8s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenUint8s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[uint8](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
 
-	{ // Min
-		input := makeVector[uint16](70)
-		expect := min(input)
-		result := MinUint16s(input)
-		assert.EqualValues(t, expect, result)
+			assert.Equal(t, expect, CompareUint8s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareUint8s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareUint8s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareUint8s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareUint8s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareUint8s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
 	}
+}
 
-	{ // Max
-		input := makeVector[uint16](70)
-		expect := max(input)
-		result := MaxUint16s(input)
-		assert.EqualValues(t, expect, result)
+// ---------------------------------- Test Masked Uint8 ----------------------------------
+
+func TestUint8_Masked(t *testing.T) {
+	rangeTiers(t, testUint8Masked)
+}
+
+func testUint8Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint8](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanUint8s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []uint8
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumUint8sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumUint8sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumUint8sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinUint8sMasked(input, mask)
+			hi, okHi := MaxUint8sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]uint8, capacity+1), make([]uint8, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressUint8s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]uint8{}, selected[:count]...), append([]uint8{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]uint8, size), uint8(7)
+			for i := range other {
+				other[i] = uint8(i % 13)
+			}
+
+			expect, expectScalar := make([]uint8, 0, size), make([]uint8, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectUint8s(make([]uint8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]uint8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]uint8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectUint8s(make([]uint8, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarUint8s(make([]uint8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]uint8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]uint8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectUint8s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
 	}
+}
 
-	{ // Add
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := add(make([]uint16, 70), input1, input2)
-		result := AddUint16s(make([]uint16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+
+// ---------------------------------- Test Div Uint8 ----------------------------------
+
+func TestUint8_Div(t *testing.T) {
+	rangeTiers(t, testUint8Div)
+}
+
+func testUint8Div(t *testing.T) {
+	top := uint8(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
 	}
 
-	{ // Sub
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := sub(make([]uint16, 70), input1, input2)
-		result := SubUint16s(make([]uint16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	// Pseudo-random values over the full range, along with the extremes
+	input := []uint8{0, 1, 2, 3, top, top + 1, top - 1, ^uint8(0), ^uint8(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, uint8(x^x>>29), uint8(x>>59), uint8(x>>53))
 	}
 
-	{ // Mul
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := mul(make([]uint16, 70), input1, input2)
-		result := MulUint16s(make([]uint16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]uint8, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarUint8s(make([]uint8, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]uint8, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivUint8s(make([]uint8, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]uint8, len(divisors)), divisors, value), RevDivScalarUint8s(make([]uint8, len(divisors)), divisors, value), "value=%d", value)
 	}
 
-	{ // Div
-		input1 := makeVector[uint16](70)
-		input2 := makeVector[uint16](70)
-		expect := div(make([]uint16, 70), input1, input2)
-		result := DivUint16s(make([]uint16, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]uint8, 100), makeVector[uint8](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivUint8s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarUint8s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
 	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarUint8s(make([]uint8, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]uint8, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarUint8s(nil, input, 0) })
 }
 
-// ---------------------------------- Benchmark Uint32 ----------------------------------
 
-func BenchmarkUint32(b *testing.B) {
+// ---------------------------------- Benchmark Uint16 ----------------------------------
+
+func BenchmarkUint16(b *testing.B) {
 	result := make([]Result, 0, 64)
 
-	typ := "uint32"
+	typ := "uint16"
 	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[uint32](count)
+		vector := makeVector[uint16](count)
 		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := uint32(0)
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = SumUint32s(vector)
+				result = SumUint16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint16sToUint64(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := uint32(0)
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = MinUint32s(vector)
+				result = MinUint16s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := uint32(0)
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = MaxUint32s(vector)
+				result = MaxUint16s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		output := make([]uint32, count)
-		input1 := makeVector[uint32](count)
-		input2 := makeVector[uint32](count)
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := uint16(0), uint16(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxUint16s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinUint16s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxUint16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint16(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint16s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]uint16, count)
+		input1 := makeVector[uint16](count)
+		input2 := makeVector[uint16](count)
 		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []uint32
+			var result []uint16
 			for i := 0; i < b.N; i++ {
-				result = AddUint32s(output, input1, input2)
+				result = AddUint16s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []uint32
+			var result []uint16
 			for i := 0; i < b.N; i++ {
-				result = SubUint32s(output, input1, input2)
+				result = SubUint16s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []uint32
+			var result []uint16
 			for i := 0; i < b.N; i++ {
-				result = MulUint32s(output, input1, input2)
+				result = MulUint16s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []uint32
+			var result []uint16
 			for i := 0; i < b.N; i++ {
-				result = DivUint32s(output, input1, input2)
+				result = DivUint16s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
-	}
-
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
-	}
-}
 
-// ---------------------------------- Test Uint32 ----------------------------------
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = MinOfUint16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-func TestUint32_Ops(t *testing.T) {
-	{ // Sum
-		input := makeVector[uint32](70)
-		expect := sum(input)
-		result := SumUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = MaxOfUint16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Min
-		input := makeVector[uint32](70)
-		expect := min(input)
-		result := MinUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = ClampUint16s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Max
-		input := makeVector[uint32](70)
-		expect := max(input)
-		result := MaxUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsUint16s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Add
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := add(make([]uint32, 70), input1, input2)
-		result := AddUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenUint16s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Sub
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := sub(make([]uint32, 70), input1, input2)
-		result := SubUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareUint16s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Mul
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := mul(make([]uint32, 70), input1, input2)
-		result := MulUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanUint16s(input1, 30, make([]uint64, count/64))
+			var result uint16
+			for i := 0; i < b.N; i++ {
+				result = SumUint16sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-	{ // Div
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := div(make([]uint32, 70), input1, input2)
-		result := DivUint32s(make([]uint32, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
-	}
-}
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanUint16s(input1, 30, make([]uint64, count/64))
+			var result uint16
+			for i := 0; i < b.N; i++ {
+				result, _ = MinUint16sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-// ---------------------------------- Test Fallback Uint32 ----------------------------------
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanUint16s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressUint16s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-func TestUint32_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanUint16s(input1, 30, make([]uint64, count/64))
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = SelectUint16s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Sum
-		input := makeVector[uint32](70)
-		expect := sum(input)
-		result := SumUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = AddScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Min
-		input := makeVector[uint32](70)
-		expect := min(input)
-		result := MinUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = SubScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Max
-		input := makeVector[uint32](70)
-		expect := max(input)
-		result := MaxUint32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Add
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := add(make([]uint32, 70), input1, input2)
-		result := AddUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = MulScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Sub
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := sub(make([]uint32, 70), input1, input2)
-		result := SubUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = DivScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Mul
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := mul(make([]uint32, 70), input1, input2)
-		result := MulUint32s(make([]uint32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []uint16
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarUint16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 	}
 
-	{ // Div
-		input1 := makeVector[uint32](70)
-		input2 := makeVector[uint32](70)
-		expect := div(make([]uint32, 70), input1, input2)
-		result := DivUint32s(make([]uint32, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
 	}
 }
 
-// ---------------------------------- Benchmark Uint64 ----------------------------------
-
-func BenchmarkUint64(b *testing.B) {
+func BenchmarkUint16_Small(b *testing.B) {
 	result := make([]Result, 0, 64)
 
-	typ := "uint64"
-	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[uint64](count)
+	typ := "uint16"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[uint16](count)
+		output := make([]uint16, count)
 		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := uint64(0)
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = SumUint64s(vector)
+				result = SumUint16s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := uint64(0)
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = MinUint64s(vector)
+				result = MaxUint16s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := uint64(0)
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint16(0)
 			for i := 0; i < b.N; i++ {
-				result = MaxUint64s(vector)
+				result = DotUint16s(vector, vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		output := make([]uint64, count)
-		input1 := makeVector[uint64](count)
-		input2 := makeVector[uint64](count)
 		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []uint64
-			for i := 0; i < b.N; i++ {
-				result = AddUint64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []uint64
-			for i := 0; i < b.N; i++ {
-				result = SubUint64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []uint64
-			for i := 0; i < b.N; i++ {
-				result = MulUint64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []uint64
+			var result []uint16
 			for i := 0; i < b.N; i++ {
-				result = DivUint64s(output, input1, input2)
+				result = AddUint16s(output, vector, vector)
 			}
 			assert.NotEmpty(b, result)
 		}))
@@ -680,582 +1262,6566 @@ func BenchmarkUint64(b *testing.B) {
 	}
 }
 
-// ---------------------------------- Test Uint64 ----------------------------------
+// ---------------------------------- Test Uint16 ----------------------------------
 
-func TestUint64_Ops(t *testing.T) {
+func TestUint16_Ops(t *testing.T) {
+	rangeTiers(t, testUint16Ops)
+}
+
+func testUint16Ops(t *testing.T) {
 	{ // Sum
-		input := makeVector[uint64](70)
+		input := makeVector[uint16](70)
 		expect := sum(input)
-		result := SumUint64s(input)
+		result := SumUint16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[uint16](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint16sToUint64(input)
+		assert.EqualValues(t, 50500, result)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Min
-		input := makeVector[uint64](70)
+		input := makeVector[uint16](70)
 		expect := min(input)
-		result := MinUint64s(input)
+		result := MinUint16s(input)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Max
-		input := makeVector[uint64](70)
+		input := makeVector[uint16](70)
 		expect := max(input)
-		result := MaxUint64s(input)
+		result := MaxUint16s(input)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := add(make([]uint64, 70), input1, input2)
-		result := AddUint64s(make([]uint64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // MinMax
+		input := makeVector[uint16](70)
+		lo, hi := MinMaxUint16s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
 	}
 
-	{ // Sub
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := sub(make([]uint64, 70), input1, input2)
-		result := SubUint64s(make([]uint64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // ArgMin
+		input := makeVector[uint16](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint16s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
 	}
 
-	{ // Mul
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := mul(make([]uint64, 70), input1, input2)
-		result := MulUint64s(make([]uint64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // ArgMax
+		input := makeVector[uint16](1000)
+		expect := argmax(input)
+		result := ArgMaxUint16s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
 	}
 
-	{ // Div
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := div(make([]uint64, 70), input1, input2)
-		result := DivUint64s(make([]uint64, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	{ // Dot
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := dot(input1, input2)
+		result := DotUint16s(input1, input2)
+		assert.EqualValues(t, expect, result)
 	}
-}
 
-// ---------------------------------- Test Fallback Uint64 ----------------------------------
-
-func TestUint64_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+	{ // Add
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := add(make([]uint16, 70), input1, input2)
+		result := AddUint16s(make([]uint16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
 
-	{ // Sum
-		input := makeVector[uint64](70)
-		expect := sum(input)
-		result := SumUint64s(input)
+	{ // Sub
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := sub(make([]uint16, 70), input1, input2)
+		result := SubUint16s(make([]uint16, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Min
-		input := makeVector[uint64](70)
-		expect := min(input)
-		result := MinUint64s(input)
+	{ // Mul
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := mul(make([]uint16, 70), input1, input2)
+		result := MulUint16s(make([]uint16, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Max
-		input := makeVector[uint64](70)
-		expect := max(input)
-		result := MaxUint64s(input)
+	{ // Div
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := div(make([]uint16, 70), input1, input2)
+		result := DivUint16s(make([]uint16, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[uint16](70)
+		expect := addScalar(make([]uint16, 70), input, 2)
+		result := AddScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := add(make([]uint64, 70), input1, input2)
-		result := AddUint64s(make([]uint64, 70), input1, input2)
+	{ // SubScalar
+		input := makeVector[uint16](70)
+		expect := subScalar(make([]uint16, 70), input, 2)
+		result := SubScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Sub
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := sub(make([]uint64, 70), input1, input2)
-		result := SubUint64s(make([]uint64, 70), input1, input2)
+	{ // RevSubScalar
+		input := makeVector[uint16](70)
+		expect := rsubScalar(make([]uint16, 70), input, 2)
+		result := RevSubScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Mul
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := mul(make([]uint64, 70), input1, input2)
-		result := MulUint64s(make([]uint64, 70), input1, input2)
+	{ // MulScalar
+		input := makeVector[uint16](70)
+		expect := mulScalar(make([]uint16, 70), input, 2)
+		result := MulScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Div
-		input1 := makeVector[uint64](70)
-		input2 := makeVector[uint64](70)
-		expect := div(make([]uint64, 70), input1, input2)
-		result := DivUint64s(make([]uint64, 70), input1, input2)
+	{ // DivScalar
+		input := makeVector[uint16](70)
+		expect := divScalar(make([]uint16, 70), input, 2)
+		result := DivScalarUint16s(make([]uint16, 70), input, 2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
-}
-
-// ---------------------------------- Benchmark Int8 ----------------------------------
-
-func BenchmarkInt8(b *testing.B) {
-	result := make([]Result, 0, 64)
-
-	typ := "int8"
-	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[int8](count)
-		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := int8(0)
-			for i := 0; i < b.N; i++ {
-				result = SumInt8s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := int8(0)
-			for i := 0; i < b.N; i++ {
-				result = MinInt8s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := int8(0)
-			for i := 0; i < b.N; i++ {
-				result = MaxInt8s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
+	{ // RevDivScalar
+		input := makeVector[uint16](70)
+		expect := rdivScalar(make([]uint16, 70), input, 2)
+		result := RevDivScalarUint16s(make([]uint16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
 
-		output := make([]int8, count)
-		input1 := makeVector[int8](count)
-		input2 := makeVector[int8](count)
-		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []int8
-			for i := 0; i < b.N; i++ {
-				result = AddInt8s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+	{ // MinOf
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](90)[20:]
+		expect := minOf(make([]uint16, 70), input1, input2)
+		result := MinOfUint16s(make([]uint16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
 
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []int8
-			for i := 0; i < b.N; i++ {
-				result = SubInt8s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+	{ // MaxOf
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](90)[20:]
+		expect := maxOf(make([]uint16, 70), input1, input2)
+		result := MaxOfUint16s(make([]uint16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
 
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []int8
-			for i := 0; i < b.N; i++ {
-				result = MulInt8s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+	{ // MinOfScalar
+		input := makeVector[uint16](70)
+		expect := minOfScalar(make([]uint16, 70), input, 50)
+		result := MinOfScalarUint16s(make([]uint16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
 
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []int8
-			for i := 0; i < b.N; i++ {
-				result = DivInt8s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+	{ // MaxOfScalar
+		input := makeVector[uint16](70)
+		expect := maxOfScalar(make([]uint16, 70), input, 50)
+		result := MaxOfScalarUint16s(make([]uint16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
 	}
 
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
+	{ // Clamp
+		input := makeVector[uint16](70)
+		expect := clamp(make([]uint16, 70), input, 20, 60)
+		result := ClampUint16s(make([]uint16, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint16, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint16, 70), input, 60, 20), ClampUint16s(make([]uint16, 70), input, 60, 20))
 	}
 }
 
-// ---------------------------------- Test Int8 ----------------------------------
+// ---------------------------------- Test Fallback Uint16 ----------------------------------
+
+func TestUint16_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
 
-func TestInt8_Ops(t *testing.T) {
 	{ // Sum
-		input := makeVector[int8](70)
+		input := makeVector[uint16](70)
 		expect := sum(input)
-		result := SumInt8s(input)
+		result := SumUint16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[uint16](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint16sToUint64(input)
+		assert.EqualValues(t, 50500, result)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Min
-		input := makeVector[int8](70)
+		input := makeVector[uint16](70)
 		expect := min(input)
-		result := MinInt8s(input)
+		result := MinUint16s(input)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Max
-		input := makeVector[int8](70)
+		input := makeVector[uint16](70)
 		expect := max(input)
-		result := MaxInt8s(input)
+		result := MaxUint16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[uint16](70)
+		lo, hi := MinMaxUint16s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint16](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint16s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint16](1000)
+		expect := argmax(input)
+		result := ArgMaxUint16s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := dot(input1, input2)
+		result := DotUint16s(input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Add
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := add(make([]int8, 70), input1, input2)
-		result := AddInt8s(make([]int8, 70), input1, input2)
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := add(make([]uint16, 70), input1, input2)
+		result := AddUint16s(make([]uint16, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Sub
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := sub(make([]int8, 70), input1, input2)
-		result := SubInt8s(make([]int8, 70), input1, input2)
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := sub(make([]uint16, 70), input1, input2)
+		result := SubUint16s(make([]uint16, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Mul
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := mul(make([]int8, 70), input1, input2)
-		result := MulInt8s(make([]int8, 70), input1, input2)
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := mul(make([]uint16, 70), input1, input2)
+		result := MulUint16s(make([]uint16, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Div
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := div(make([]int8, 70), input1, input2)
-		result := DivInt8s(make([]int8, 70), input1, input2)
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](70)
+		expect := div(make([]uint16, 70), input1, input2)
+		result := DivUint16s(make([]uint16, 70), input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
-}
-
-// ---------------------------------- Test Fallback Int8 ----------------------------------
-
-func TestInt8_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
 
-	{ // Sum
-		input := makeVector[int8](70)
-		expect := sum(input)
-		result := SumInt8s(input)
+	{ // AddScalar
+		input := makeVector[uint16](70)
+		expect := addScalar(make([]uint16, 70), input, 2)
+		result := AddScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Min
-		input := makeVector[int8](70)
-		expect := min(input)
-		result := MinInt8s(input)
+	{ // SubScalar
+		input := makeVector[uint16](70)
+		expect := subScalar(make([]uint16, 70), input, 2)
+		result := SubScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Max
-		input := makeVector[int8](70)
-		expect := max(input)
-		result := MaxInt8s(input)
+	{ // RevSubScalar
+		input := makeVector[uint16](70)
+		expect := rsubScalar(make([]uint16, 70), input, 2)
+		result := RevSubScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := add(make([]int8, 70), input1, input2)
-		result := AddInt8s(make([]int8, 70), input1, input2)
+	{ // MulScalar
+		input := makeVector[uint16](70)
+		expect := mulScalar(make([]uint16, 70), input, 2)
+		result := MulScalarUint16s(make([]uint16, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Sub
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := sub(make([]int8, 70), input1, input2)
-		result := SubInt8s(make([]int8, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // DivScalar
+		input := makeVector[uint16](70)
+		expect := divScalar(make([]uint16, 70), input, 2)
+		result := DivScalarUint16s(make([]uint16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
 
-	{ // Mul
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := mul(make([]int8, 70), input1, input2)
-		result := MulInt8s(make([]int8, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // RevDivScalar
+		input := makeVector[uint16](70)
+		expect := rdivScalar(make([]uint16, 70), input, 2)
+		result := RevDivScalarUint16s(make([]uint16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
 
-	{ // Div
-		input1 := makeVector[int8](70)
-		input2 := makeVector[int8](70)
-		expect := div(make([]int8, 70), input1, input2)
-		result := DivInt8s(make([]int8, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	{ // MinOf
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](90)[20:]
+		expect := minOf(make([]uint16, 70), input1, input2)
+		result := MinOfUint16s(make([]uint16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
 	}
-}
 
-// ---------------------------------- Benchmark Int16 ----------------------------------
+	{ // MaxOf
+		input1 := makeVector[uint16](70)
+		input2 := makeVector[uint16](90)[20:]
+		expect := maxOf(make([]uint16, 70), input1, input2)
+		result := MaxOfUint16s(make([]uint16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
 
-func BenchmarkInt16(b *testing.B) {
-	result := make([]Result, 0, 64)
+	{ // MinOfScalar
+		input := makeVector[uint16](70)
+		expect := minOfScalar(make([]uint16, 70), input, 50)
+		result := MinOfScalarUint16s(make([]uint16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
 
-	typ := "int16"
-	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[int16](count)
-		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := int16(0)
-			for i := 0; i < b.N; i++ {
-				result = SumInt16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
+	{ // MaxOfScalar
+		input := makeVector[uint16](70)
+		expect := maxOfScalar(make([]uint16, 70), input, 50)
+		result := MaxOfScalarUint16s(make([]uint16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
 
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := int16(0)
-			for i := 0; i < b.N; i++ {
-				result = MinInt16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
+	{ // Clamp
+		input := makeVector[uint16](70)
+		expect := clamp(make([]uint16, 70), input, 20, 60)
+		result := ClampUint16s(make([]uint16, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint16, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint16, 70), input, 60, 20), ClampUint16s(make([]uint16, 70), input, 60, 20))
+	}
+}
 
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := int16(0)
-			for i := 0; i < b.N; i++ {
-				result = MaxInt16s(vector)
-			}
-			assert.NotZero(b, result)
-		}))
+// ---------------------------------- Test Bounds Uint16 ----------------------------------
+
+func TestUint16_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []uint16{}
+		assert.Zero(t, SumUint16s(nil), mode)
+		assert.Zero(t, SumUint16s(empty), mode)
+		assert.Zero(t, DotUint16s(empty, makeVector[uint16](70)), mode)
+		assert.Zero(t, SumUint16sToUint64(empty), mode)
+		assert.Panics(t, func() { MinUint16s(empty) }, mode)
+		assert.Panics(t, func() { MaxUint16s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxUint16s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinUint16s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxUint16s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[uint16](70), makeVector[uint16](33)
+		assert.EqualValues(t, dot(short, short), DotUint16s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotUint16s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]uint16, n[0]), makeVector[uint16](n[1]), makeVector[uint16](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]uint16, size), input1, input2), AddUint16s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]uint16, size), input1, input2), SubUint16s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]uint16, size), input1, input2), MulUint16s(dst, input1, input2), mode)
+			assert.Len(t, DivUint16s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]uint16, size), input1, input2), MinOfUint16s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]uint16, size), input1, input2), MaxOfUint16s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarUint16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampUint16s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Uint16 ----------------------------------
 
-		output := make([]int16, count)
-		input1 := makeVector[int16](count)
-		input2 := makeVector[int16](count)
-		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []int16
-			for i := 0; i < b.N; i++ {
-				result = AddInt16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
+func TestUint16_Compare(t *testing.T) {
+	rangeTiers(t, testUint16Compare)
+}
 
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []int16
-			for i := 0; i < b.N; i++ {
-				result = SubInt16s(output, input1, input2)
+func testUint16Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint16](size)
+		words := (size + 63) / 64
+		for _, value := range []uint16{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
 			}
-			assert.NotEmpty(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []int16
-			for i := 0; i < b.N; i++ {
-				result = MulInt16s(output, input1, input2)
+			assert.Equal(t, expect, EqualsUint16s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsUint16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanUint16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanUint16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenUint16s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsUint16s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanUint16s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanUint16s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenUint16s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenUint16s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[uint16](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
 			}
-			assert.NotEmpty(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []int16
-			for i := 0; i < b.N; i++ {
-				result = DivInt16s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-	}
+			assert.Equal(t, expect, CompareUint16s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareUint16s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareUint16s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareUint16s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareUint16s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
 
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
+		if size > 0 {
+			assert.Panics(t, func() { CompareUint16s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
 	}
 }
 
-// ---------------------------------- Test Int16 ----------------------------------
+// ---------------------------------- Test Masked Uint16 ----------------------------------
 
-func TestInt16_Ops(t *testing.T) {
-	{ // Sum
-		input := makeVector[int16](70)
-		expect := sum(input)
-		result := SumInt16s(input)
-		assert.EqualValues(t, expect, result)
-	}
+func TestUint16_Masked(t *testing.T) {
+	rangeTiers(t, testUint16Masked)
+}
 
-	{ // Min
-		input := makeVector[int16](70)
-		expect := min(input)
-		result := MinInt16s(input)
-		assert.EqualValues(t, expect, result)
-	}
+func testUint16Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint16](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanUint16s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []uint16
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
 
-	{ // Max
-		input := makeVector[int16](70)
-		expect := max(input)
-		result := MaxInt16s(input)
-		assert.EqualValues(t, expect, result)
-	}
+			assert.EqualValues(t, sum(selected), SumUint16sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumUint16sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumUint16sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinUint16sMasked(input, mask)
+			hi, okHi := MaxUint16sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
 
-	{ // Add
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := add(make([]int16, 70), input1, input2)
-		result := AddInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]uint16, capacity+1), make([]uint16, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressUint16s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]uint16{}, selected[:count]...), append([]uint16{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
 
-	{ // Sub
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := sub(make([]int16, 70), input1, input2)
-		result := SubInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]uint16, size), uint16(7)
+			for i := range other {
+				other[i] = uint16(i % 13)
+			}
 
-	{ // Mul
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := mul(make([]int16, 70), input1, input2)
-		result := MulInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			expect, expectScalar := make([]uint16, 0, size), make([]uint16, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
 
-	{ // Div
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := div(make([]int16, 70), input1, input2)
-		result := DivInt16s(make([]int16, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+			assert.Equal(t, expect, SelectUint16s(make([]uint16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]uint16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]uint16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectUint16s(make([]uint16, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarUint16s(make([]uint16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]uint16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]uint16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectUint16s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
 	}
 }
 
-// ---------------------------------- Test Fallback Int16 ----------------------------------
 
-func TestInt16_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+// ---------------------------------- Test Div Uint16 ----------------------------------
 
-	{ // Sum
-		input := makeVector[int16](70)
-		expect := sum(input)
-		result := SumInt16s(input)
-		assert.EqualValues(t, expect, result)
-	}
+func TestUint16_Div(t *testing.T) {
+	rangeTiers(t, testUint16Div)
+}
 
-	{ // Min
-		input := makeVector[int16](70)
-		expect := min(input)
-		result := MinInt16s(input)
-		assert.EqualValues(t, expect, result)
+func testUint16Div(t *testing.T) {
+	top := uint16(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
 	}
 
-	{ // Max
-		input := makeVector[int16](70)
-		expect := max(input)
-		result := MaxInt16s(input)
-		assert.EqualValues(t, expect, result)
+	// Pseudo-random values over the full range, along with the extremes
+	input := []uint16{0, 1, 2, 3, top, top + 1, top - 1, ^uint16(0), ^uint16(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, uint16(x^x>>29), uint16(x>>59), uint16(x>>53))
 	}
 
-	{ // Add
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := add(make([]int16, 70), input1, input2)
-		result := AddInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
 
-	{ // Sub
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := sub(make([]int16, 70), input1, input2)
-		result := SubInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		expect := make([]uint16, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarUint16s(make([]uint16, len(input)), input, value), "value=%d", value)
 
-	{ // Mul
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := mul(make([]int16, 70), input1, input2)
-		result := MulInt16s(make([]int16, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+		divisors := make([]uint16, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivUint16s(make([]uint16, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]uint16, len(divisors)), divisors, value), RevDivScalarUint16s(make([]uint16, len(divisors)), divisors, value), "value=%d", value)
 	}
 
-	{ // Div
-		input1 := makeVector[int16](70)
-		input2 := makeVector[int16](70)
-		expect := div(make([]int16, 70), input1, input2)
-		result := DivInt16s(make([]int16, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]uint16, 100), makeVector[uint16](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivUint16s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarUint16s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
 	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarUint16s(make([]uint16, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]uint16, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarUint16s(nil, input, 0) })
 }
 
-// ---------------------------------- Benchmark Int32 ----------------------------------
 
-func BenchmarkInt32(b *testing.B) {
+// ---------------------------------- Benchmark Uint32 ----------------------------------
+
+func BenchmarkUint32(b *testing.B) {
 	result := make([]Result, 0, 64)
 
-	typ := "int32"
+	typ := "uint32"
 	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[int32](count)
+		vector := makeVector[uint32](count)
 		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := int32(0)
+			result := uint32(0)
 			for i := 0; i < b.N; i++ {
-				result = SumInt32s(vector)
+				result = SumUint32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint32sToUint64(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := int32(0)
+			result := uint32(0)
 			for i := 0; i < b.N; i++ {
-				result = MinInt32s(vector)
+				result = MinUint32s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := int32(0)
+			result := uint32(0)
 			for i := 0; i < b.N; i++ {
-				result = MaxInt32s(vector)
+				result = MaxUint32s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		output := make([]int32, count)
-		input1 := makeVector[int32](count)
-		input2 := makeVector[int32](count)
-		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []int32
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := uint32(0), uint32(0)
 			for i := 0; i < b.N; i++ {
-				result = AddInt32s(output, input1, input2)
+				lo, hi = MinMaxUint32s(vector)
 			}
-			assert.NotEmpty(b, result)
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []int32
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
 			for i := 0; i < b.N; i++ {
-				result = SubInt32s(output, input1, input2)
+				result = ArgMinUint32s(vector)
 			}
-			assert.NotEmpty(b, result)
+			assert.Equal(b, 0, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []int32
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
 			for i := 0; i < b.N; i++ {
-				result = MulInt32s(output, input1, input2)
+				result = ArgMaxUint32s(vector)
 			}
-			assert.NotEmpty(b, result)
+			assert.NotZero(b, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []int32
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint32(0)
 			for i := 0; i < b.N; i++ {
-				result = DivInt32s(output, input1, input2)
+				result = DotUint32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]uint32, count)
+		input1 := makeVector[uint32](count)
+		input2 := makeVector[uint32](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = AddUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = SubUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = MulUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = DivUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = MinOfUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = MaxOfUint32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = ClampUint32s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsUint32s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenUint32s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareUint32s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanUint32s(input1, 30, make([]uint64, count/64))
+			var result uint32
+			for i := 0; i < b.N; i++ {
+				result = SumUint32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanUint32s(input1, 30, make([]uint64, count/64))
+			var result uint32
+			for i := 0; i < b.N; i++ {
+				result, _ = MinUint32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanUint32s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressUint32s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanUint32s(input1, 30, make([]uint64, count/64))
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = SelectUint32s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = AddScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = SubScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = MulScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = DivScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarUint32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkUint32_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "uint32"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[uint32](count)
+		output := make([]uint32, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := uint32(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := uint32(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxUint32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint32(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []uint32
+			for i := 0; i < b.N; i++ {
+				result = AddUint32s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Uint32 ----------------------------------
+
+func TestUint32_Ops(t *testing.T) {
+	rangeTiers(t, testUint32Ops)
+}
+
+func testUint32Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[uint32](70)
+		expect := sum(input)
+		result := SumUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[uint32](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint32sToUint64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[uint32](70)
+		expect := min(input)
+		result := MinUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[uint32](70)
+		expect := max(input)
+		result := MaxUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[uint32](70)
+		lo, hi := MinMaxUint32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint32](1000)
+		expect := argmax(input)
+		result := ArgMaxUint32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := dot(input1, input2)
+		result := DotUint32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := add(make([]uint32, 70), input1, input2)
+		result := AddUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := sub(make([]uint32, 70), input1, input2)
+		result := SubUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := mul(make([]uint32, 70), input1, input2)
+		result := MulUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := div(make([]uint32, 70), input1, input2)
+		result := DivUint32s(make([]uint32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[uint32](70)
+		expect := addScalar(make([]uint32, 70), input, 2)
+		result := AddScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[uint32](70)
+		expect := subScalar(make([]uint32, 70), input, 2)
+		result := SubScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[uint32](70)
+		expect := rsubScalar(make([]uint32, 70), input, 2)
+		result := RevSubScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[uint32](70)
+		expect := mulScalar(make([]uint32, 70), input, 2)
+		result := MulScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[uint32](70)
+		expect := divScalar(make([]uint32, 70), input, 2)
+		result := DivScalarUint32s(make([]uint32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[uint32](70)
+		expect := rdivScalar(make([]uint32, 70), input, 2)
+		result := RevDivScalarUint32s(make([]uint32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](90)[20:]
+		expect := minOf(make([]uint32, 70), input1, input2)
+		result := MinOfUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](90)[20:]
+		expect := maxOf(make([]uint32, 70), input1, input2)
+		result := MaxOfUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[uint32](70)
+		expect := minOfScalar(make([]uint32, 70), input, 50)
+		result := MinOfScalarUint32s(make([]uint32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[uint32](70)
+		expect := maxOfScalar(make([]uint32, 70), input, 50)
+		result := MaxOfScalarUint32s(make([]uint32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[uint32](70)
+		expect := clamp(make([]uint32, 70), input, 20, 60)
+		result := ClampUint32s(make([]uint32, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint32, 70), input, 60, 20), ClampUint32s(make([]uint32, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Uint32 ----------------------------------
+
+func TestUint32_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[uint32](70)
+		expect := sum(input)
+		result := SumUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[uint32](1000)
+		expect := sumWide[uint64](input)
+		result := SumUint32sToUint64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[uint32](70)
+		expect := min(input)
+		result := MinUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[uint32](70)
+		expect := max(input)
+		result := MaxUint32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[uint32](70)
+		lo, hi := MinMaxUint32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint32](1000)
+		expect := argmax(input)
+		result := ArgMaxUint32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := dot(input1, input2)
+		result := DotUint32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := add(make([]uint32, 70), input1, input2)
+		result := AddUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := sub(make([]uint32, 70), input1, input2)
+		result := SubUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := mul(make([]uint32, 70), input1, input2)
+		result := MulUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](70)
+		expect := div(make([]uint32, 70), input1, input2)
+		result := DivUint32s(make([]uint32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[uint32](70)
+		expect := addScalar(make([]uint32, 70), input, 2)
+		result := AddScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[uint32](70)
+		expect := subScalar(make([]uint32, 70), input, 2)
+		result := SubScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[uint32](70)
+		expect := rsubScalar(make([]uint32, 70), input, 2)
+		result := RevSubScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[uint32](70)
+		expect := mulScalar(make([]uint32, 70), input, 2)
+		result := MulScalarUint32s(make([]uint32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[uint32](70)
+		expect := divScalar(make([]uint32, 70), input, 2)
+		result := DivScalarUint32s(make([]uint32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[uint32](70)
+		expect := rdivScalar(make([]uint32, 70), input, 2)
+		result := RevDivScalarUint32s(make([]uint32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](90)[20:]
+		expect := minOf(make([]uint32, 70), input1, input2)
+		result := MinOfUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[uint32](70)
+		input2 := makeVector[uint32](90)[20:]
+		expect := maxOf(make([]uint32, 70), input1, input2)
+		result := MaxOfUint32s(make([]uint32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[uint32](70)
+		expect := minOfScalar(make([]uint32, 70), input, 50)
+		result := MinOfScalarUint32s(make([]uint32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[uint32](70)
+		expect := maxOfScalar(make([]uint32, 70), input, 50)
+		result := MaxOfScalarUint32s(make([]uint32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[uint32](70)
+		expect := clamp(make([]uint32, 70), input, 20, 60)
+		result := ClampUint32s(make([]uint32, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint32, 70), input, 60, 20), ClampUint32s(make([]uint32, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Uint32 ----------------------------------
+
+func TestUint32_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []uint32{}
+		assert.Zero(t, SumUint32s(nil), mode)
+		assert.Zero(t, SumUint32s(empty), mode)
+		assert.Zero(t, DotUint32s(empty, makeVector[uint32](70)), mode)
+		assert.Zero(t, SumUint32sToUint64(empty), mode)
+		assert.Panics(t, func() { MinUint32s(empty) }, mode)
+		assert.Panics(t, func() { MaxUint32s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxUint32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinUint32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxUint32s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[uint32](70), makeVector[uint32](33)
+		assert.EqualValues(t, dot(short, short), DotUint32s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotUint32s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]uint32, n[0]), makeVector[uint32](n[1]), makeVector[uint32](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]uint32, size), input1, input2), AddUint32s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]uint32, size), input1, input2), SubUint32s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]uint32, size), input1, input2), MulUint32s(dst, input1, input2), mode)
+			assert.Len(t, DivUint32s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]uint32, size), input1, input2), MinOfUint32s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]uint32, size), input1, input2), MaxOfUint32s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarUint32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampUint32s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Uint32 ----------------------------------
+
+func TestUint32_Compare(t *testing.T) {
+	rangeTiers(t, testUint32Compare)
+}
+
+func testUint32Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint32](size)
+		words := (size + 63) / 64
+		for _, value := range []uint32{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsUint32s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsUint32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanUint32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanUint32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenUint32s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsUint32s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanUint32s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanUint32s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenUint32s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenUint32s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[uint32](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareUint32s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareUint32s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareUint32s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareUint32s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareUint32s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareUint32s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Uint32 ----------------------------------
+
+func TestUint32_Masked(t *testing.T) {
+	rangeTiers(t, testUint32Masked)
+}
+
+func testUint32Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint32](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanUint32s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []uint32
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumUint32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumUint32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumUint32sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinUint32sMasked(input, mask)
+			hi, okHi := MaxUint32sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]uint32, capacity+1), make([]uint32, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressUint32s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]uint32{}, selected[:count]...), append([]uint32{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]uint32, size), uint32(7)
+			for i := range other {
+				other[i] = uint32(i % 13)
+			}
+
+			expect, expectScalar := make([]uint32, 0, size), make([]uint32, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectUint32s(make([]uint32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]uint32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]uint32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectUint32s(make([]uint32, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarUint32s(make([]uint32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]uint32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]uint32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectUint32s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Uint32 ----------------------------------
+
+func TestUint32_Div(t *testing.T) {
+	rangeTiers(t, testUint32Div)
+}
+
+func testUint32Div(t *testing.T) {
+	top := uint32(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []uint32{0, 1, 2, 3, top, top + 1, top - 1, ^uint32(0), ^uint32(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, uint32(x^x>>29), uint32(x>>59), uint32(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]uint32, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarUint32s(make([]uint32, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]uint32, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivUint32s(make([]uint32, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]uint32, len(divisors)), divisors, value), RevDivScalarUint32s(make([]uint32, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]uint32, 100), makeVector[uint32](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivUint32s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarUint32s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarUint32s(make([]uint32, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]uint32, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarUint32s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Uint64 ----------------------------------
+
+func BenchmarkUint64(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "uint64"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[uint64](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = MinUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := uint64(0), uint64(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxUint64s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinUint64s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint64s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]uint64, count)
+		input1 := makeVector[uint64](count)
+		input2 := makeVector[uint64](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = AddUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = SubUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = MulUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = DivUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = MinOfUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = MaxOfUint64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = ClampUint64s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsUint64s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenUint64s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareUint64s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanUint64s(input1, 30, make([]uint64, count/64))
+			var result uint64
+			for i := 0; i < b.N; i++ {
+				result = SumUint64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanUint64s(input1, 30, make([]uint64, count/64))
+			var result uint64
+			for i := 0; i < b.N; i++ {
+				result, _ = MinUint64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanUint64s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressUint64s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanUint64s(input1, 30, make([]uint64, count/64))
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = SelectUint64s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = AddScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = SubScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = MulScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = DivScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarUint64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkUint64_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "uint64"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[uint64](count)
+		output := make([]uint64, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxUint64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := uint64(0)
+			for i := 0; i < b.N; i++ {
+				result = DotUint64s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []uint64
+			for i := 0; i < b.N; i++ {
+				result = AddUint64s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Uint64 ----------------------------------
+
+func TestUint64_Ops(t *testing.T) {
+	rangeTiers(t, testUint64Ops)
+}
+
+func testUint64Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[uint64](70)
+		expect := sum(input)
+		result := SumUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[uint64](70)
+		expect := min(input)
+		result := MinUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[uint64](70)
+		expect := max(input)
+		result := MaxUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[uint64](70)
+		lo, hi := MinMaxUint64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint64](1000)
+		expect := argmax(input)
+		result := ArgMaxUint64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := dot(input1, input2)
+		result := DotUint64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := add(make([]uint64, 70), input1, input2)
+		result := AddUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := sub(make([]uint64, 70), input1, input2)
+		result := SubUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := mul(make([]uint64, 70), input1, input2)
+		result := MulUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := div(make([]uint64, 70), input1, input2)
+		result := DivUint64s(make([]uint64, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[uint64](70)
+		expect := addScalar(make([]uint64, 70), input, 2)
+		result := AddScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[uint64](70)
+		expect := subScalar(make([]uint64, 70), input, 2)
+		result := SubScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[uint64](70)
+		expect := rsubScalar(make([]uint64, 70), input, 2)
+		result := RevSubScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[uint64](70)
+		expect := mulScalar(make([]uint64, 70), input, 2)
+		result := MulScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[uint64](70)
+		expect := divScalar(make([]uint64, 70), input, 2)
+		result := DivScalarUint64s(make([]uint64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[uint64](70)
+		expect := rdivScalar(make([]uint64, 70), input, 2)
+		result := RevDivScalarUint64s(make([]uint64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](90)[20:]
+		expect := minOf(make([]uint64, 70), input1, input2)
+		result := MinOfUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](90)[20:]
+		expect := maxOf(make([]uint64, 70), input1, input2)
+		result := MaxOfUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[uint64](70)
+		expect := minOfScalar(make([]uint64, 70), input, 50)
+		result := MinOfScalarUint64s(make([]uint64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[uint64](70)
+		expect := maxOfScalar(make([]uint64, 70), input, 50)
+		result := MaxOfScalarUint64s(make([]uint64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[uint64](70)
+		expect := clamp(make([]uint64, 70), input, 20, 60)
+		result := ClampUint64s(make([]uint64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint64, 70), input, 60, 20), ClampUint64s(make([]uint64, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Uint64 ----------------------------------
+
+func TestUint64_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[uint64](70)
+		expect := sum(input)
+		result := SumUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[uint64](70)
+		expect := min(input)
+		result := MinUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[uint64](70)
+		expect := max(input)
+		result := MaxUint64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[uint64](70)
+		lo, hi := MinMaxUint64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[uint64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinUint64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[uint64](1000)
+		expect := argmax(input)
+		result := ArgMaxUint64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := dot(input1, input2)
+		result := DotUint64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := add(make([]uint64, 70), input1, input2)
+		result := AddUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := sub(make([]uint64, 70), input1, input2)
+		result := SubUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := mul(make([]uint64, 70), input1, input2)
+		result := MulUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](70)
+		expect := div(make([]uint64, 70), input1, input2)
+		result := DivUint64s(make([]uint64, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[uint64](70)
+		expect := addScalar(make([]uint64, 70), input, 2)
+		result := AddScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[uint64](70)
+		expect := subScalar(make([]uint64, 70), input, 2)
+		result := SubScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[uint64](70)
+		expect := rsubScalar(make([]uint64, 70), input, 2)
+		result := RevSubScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[uint64](70)
+		expect := mulScalar(make([]uint64, 70), input, 2)
+		result := MulScalarUint64s(make([]uint64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[uint64](70)
+		expect := divScalar(make([]uint64, 70), input, 2)
+		result := DivScalarUint64s(make([]uint64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[uint64](70)
+		expect := rdivScalar(make([]uint64, 70), input, 2)
+		result := RevDivScalarUint64s(make([]uint64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](90)[20:]
+		expect := minOf(make([]uint64, 70), input1, input2)
+		result := MinOfUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[uint64](70)
+		input2 := makeVector[uint64](90)[20:]
+		expect := maxOf(make([]uint64, 70), input1, input2)
+		result := MaxOfUint64s(make([]uint64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[uint64](70)
+		expect := minOfScalar(make([]uint64, 70), input, 50)
+		result := MinOfScalarUint64s(make([]uint64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[uint64](70)
+		expect := maxOfScalar(make([]uint64, 70), input, 50)
+		result := MaxOfScalarUint64s(make([]uint64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[uint64](70)
+		expect := clamp(make([]uint64, 70), input, 20, 60)
+		result := ClampUint64s(make([]uint64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]uint64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]uint64, 70), input, 60, 20), ClampUint64s(make([]uint64, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Uint64 ----------------------------------
+
+func TestUint64_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []uint64{}
+		assert.Zero(t, SumUint64s(nil), mode)
+		assert.Zero(t, SumUint64s(empty), mode)
+		assert.Zero(t, DotUint64s(empty, makeVector[uint64](70)), mode)
+		assert.Panics(t, func() { MinUint64s(empty) }, mode)
+		assert.Panics(t, func() { MaxUint64s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxUint64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinUint64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxUint64s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[uint64](70), makeVector[uint64](33)
+		assert.EqualValues(t, dot(short, short), DotUint64s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotUint64s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]uint64, n[0]), makeVector[uint64](n[1]), makeVector[uint64](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]uint64, size), input1, input2), AddUint64s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]uint64, size), input1, input2), SubUint64s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]uint64, size), input1, input2), MulUint64s(dst, input1, input2), mode)
+			assert.Len(t, DivUint64s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]uint64, size), input1, input2), MinOfUint64s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]uint64, size), input1, input2), MaxOfUint64s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarUint64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampUint64s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Uint64 ----------------------------------
+
+func TestUint64_Compare(t *testing.T) {
+	rangeTiers(t, testUint64Compare)
+}
+
+func testUint64Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint64](size)
+		words := (size + 63) / 64
+		for _, value := range []uint64{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsUint64s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsUint64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanUint64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanUint64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenUint64s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsUint64s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanUint64s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanUint64s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenUint64s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenUint64s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[uint64](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareUint64s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareUint64s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareUint64s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareUint64s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareUint64s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareUint64s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Uint64 ----------------------------------
+
+func TestUint64_Masked(t *testing.T) {
+	rangeTiers(t, testUint64Masked)
+}
+
+func testUint64Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[uint64](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanUint64s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []uint64
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumUint64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumUint64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumUint64sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinUint64sMasked(input, mask)
+			hi, okHi := MaxUint64sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]uint64, capacity+1), make([]uint64, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressUint64s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]uint64{}, selected[:count]...), append([]uint64{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]uint64, size), uint64(7)
+			for i := range other {
+				other[i] = uint64(i % 13)
+			}
+
+			expect, expectScalar := make([]uint64, 0, size), make([]uint64, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectUint64s(make([]uint64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]uint64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]uint64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectUint64s(make([]uint64, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarUint64s(make([]uint64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]uint64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]uint64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectUint64s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Uint64 ----------------------------------
+
+func TestUint64_Div(t *testing.T) {
+	rangeTiers(t, testUint64Div)
+}
+
+func testUint64Div(t *testing.T) {
+	top := uint64(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []uint64{0, 1, 2, 3, top, top + 1, top - 1, ^uint64(0), ^uint64(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, uint64(x^x>>29), uint64(x>>59), uint64(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]uint64, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarUint64s(make([]uint64, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]uint64, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivUint64s(make([]uint64, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]uint64, len(divisors)), divisors, value), RevDivScalarUint64s(make([]uint64, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]uint64, 100), makeVector[uint64](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivUint64s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarUint64s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarUint64s(make([]uint64, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]uint64, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarUint64s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Int8 ----------------------------------
+
+func BenchmarkInt8(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int8"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[int8](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt8sToInt64(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = MinInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := int8(0), int8(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxInt8s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinInt8s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt8s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]int8, count)
+		input1 := makeVector[int8](count)
+		input2 := makeVector[int8](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = AddInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = SubInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = MulInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = DivInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = MinOfInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = MaxOfInt8s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = ClampInt8s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsInt8s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenInt8s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareInt8s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanInt8s(input1, 30, make([]uint64, count/64))
+			var result int8
+			for i := 0; i < b.N; i++ {
+				result = SumInt8sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanInt8s(input1, 30, make([]uint64, count/64))
+			var result int8
+			for i := 0; i < b.N; i++ {
+				result, _ = MinInt8sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanInt8s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressInt8s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanInt8s(input1, 30, make([]uint64, count/64))
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = SelectInt8s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = AddScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = SubScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = MulScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = DivScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarInt8s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkInt8_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int8"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[int8](count)
+		output := make([]int8, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt8s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int8(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt8s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int8
+			for i := 0; i < b.N; i++ {
+				result = AddInt8s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Int8 ----------------------------------
+
+func TestInt8_Ops(t *testing.T) {
+	rangeTiers(t, testInt8Ops)
+}
+
+func testInt8Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[int8](70)
+		expect := sum(input)
+		result := SumInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int8](1000)
+		expect := sumWide[int64](input)
+		result := SumInt8sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int8](70)
+		expect := min(input)
+		result := MinInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int8](70)
+		expect := max(input)
+		result := MaxInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int8](70)
+		lo, hi := MinMaxInt8s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int8](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt8s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int8](1000)
+		expect := argmax(input)
+		result := ArgMaxInt8s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := dot(input1, input2)
+		result := DotInt8s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := add(make([]int8, 70), input1, input2)
+		result := AddInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := sub(make([]int8, 70), input1, input2)
+		result := SubInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := mul(make([]int8, 70), input1, input2)
+		result := MulInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := div(make([]int8, 70), input1, input2)
+		result := DivInt8s(make([]int8, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int8](70)
+		expect := addScalar(make([]int8, 70), input, 2)
+		result := AddScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int8](70)
+		expect := subScalar(make([]int8, 70), input, 2)
+		result := SubScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int8](70)
+		expect := rsubScalar(make([]int8, 70), input, 2)
+		result := RevSubScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int8](70)
+		expect := mulScalar(make([]int8, 70), input, 2)
+		result := MulScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int8](70)
+		expect := divScalar(make([]int8, 70), input, 2)
+		result := DivScalarInt8s(make([]int8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int8](70)
+		expect := rdivScalar(make([]int8, 70), input, 2)
+		result := RevDivScalarInt8s(make([]int8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](90)[20:]
+		expect := minOf(make([]int8, 70), input1, input2)
+		result := MinOfInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](90)[20:]
+		expect := maxOf(make([]int8, 70), input1, input2)
+		result := MaxOfInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int8](70)
+		expect := minOfScalar(make([]int8, 70), input, 50)
+		result := MinOfScalarInt8s(make([]int8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int8](70)
+		expect := maxOfScalar(make([]int8, 70), input, 50)
+		result := MaxOfScalarInt8s(make([]int8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int8](70)
+		expect := clamp(make([]int8, 70), input, 20, 60)
+		result := ClampInt8s(make([]int8, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int8, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int8, 70), input, 60, 20), ClampInt8s(make([]int8, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Int8 ----------------------------------
+
+func TestInt8_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[int8](70)
+		expect := sum(input)
+		result := SumInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int8](1000)
+		expect := sumWide[int64](input)
+		result := SumInt8sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int8](70)
+		expect := min(input)
+		result := MinInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int8](70)
+		expect := max(input)
+		result := MaxInt8s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int8](70)
+		lo, hi := MinMaxInt8s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int8](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt8s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int8](1000)
+		expect := argmax(input)
+		result := ArgMaxInt8s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := dot(input1, input2)
+		result := DotInt8s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := add(make([]int8, 70), input1, input2)
+		result := AddInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := sub(make([]int8, 70), input1, input2)
+		result := SubInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := mul(make([]int8, 70), input1, input2)
+		result := MulInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](70)
+		expect := div(make([]int8, 70), input1, input2)
+		result := DivInt8s(make([]int8, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int8](70)
+		expect := addScalar(make([]int8, 70), input, 2)
+		result := AddScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int8](70)
+		expect := subScalar(make([]int8, 70), input, 2)
+		result := SubScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int8](70)
+		expect := rsubScalar(make([]int8, 70), input, 2)
+		result := RevSubScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int8](70)
+		expect := mulScalar(make([]int8, 70), input, 2)
+		result := MulScalarInt8s(make([]int8, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int8](70)
+		expect := divScalar(make([]int8, 70), input, 2)
+		result := DivScalarInt8s(make([]int8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int8](70)
+		expect := rdivScalar(make([]int8, 70), input, 2)
+		result := RevDivScalarInt8s(make([]int8, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](90)[20:]
+		expect := minOf(make([]int8, 70), input1, input2)
+		result := MinOfInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int8](70)
+		input2 := makeVector[int8](90)[20:]
+		expect := maxOf(make([]int8, 70), input1, input2)
+		result := MaxOfInt8s(make([]int8, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int8](70)
+		expect := minOfScalar(make([]int8, 70), input, 50)
+		result := MinOfScalarInt8s(make([]int8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int8](70)
+		expect := maxOfScalar(make([]int8, 70), input, 50)
+		result := MaxOfScalarInt8s(make([]int8, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int8](70)
+		expect := clamp(make([]int8, 70), input, 20, 60)
+		result := ClampInt8s(make([]int8, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int8, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int8, 70), input, 60, 20), ClampInt8s(make([]int8, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Int8 ----------------------------------
+
+func TestInt8_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []int8{}
+		assert.Zero(t, SumInt8s(nil), mode)
+		assert.Zero(t, SumInt8s(empty), mode)
+		assert.Zero(t, DotInt8s(empty, makeVector[int8](70)), mode)
+		assert.Zero(t, SumInt8sToInt64(empty), mode)
+		assert.Panics(t, func() { MinInt8s(empty) }, mode)
+		assert.Panics(t, func() { MaxInt8s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxInt8s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinInt8s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxInt8s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[int8](70), makeVector[int8](33)
+		assert.EqualValues(t, dot(short, short), DotInt8s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotInt8s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]int8, n[0]), makeVector[int8](n[1]), makeVector[int8](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]int8, size), input1, input2), AddInt8s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]int8, size), input1, input2), SubInt8s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]int8, size), input1, input2), MulInt8s(dst, input1, input2), mode)
+			assert.Len(t, DivInt8s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]int8, size), input1, input2), MinOfInt8s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]int8, size), input1, input2), MaxOfInt8s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarInt8s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampInt8s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Int8 ----------------------------------
+
+func TestInt8_Compare(t *testing.T) {
+	rangeTiers(t, testInt8Compare)
+}
+
+func testInt8Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int8](size)
+		words := (size + 63) / 64
+		for _, value := range []int8{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsInt8s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsInt8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanInt8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanInt8s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenInt8s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsInt8s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanInt8s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanInt8s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenInt8s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenInt8s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[int8](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareInt8s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareInt8s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareInt8s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareInt8s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareInt8s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareInt8s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Int8 ----------------------------------
+
+func TestInt8_Masked(t *testing.T) {
+	rangeTiers(t, testInt8Masked)
+}
+
+func testInt8Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int8](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanInt8s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []int8
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumInt8sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumInt8sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumInt8sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinInt8sMasked(input, mask)
+			hi, okHi := MaxInt8sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]int8, capacity+1), make([]int8, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressInt8s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]int8{}, selected[:count]...), append([]int8{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]int8, size), int8(7)
+			for i := range other {
+				other[i] = int8(i % 13)
+			}
+
+			expect, expectScalar := make([]int8, 0, size), make([]int8, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectInt8s(make([]int8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]int8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]int8, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectInt8s(make([]int8, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarInt8s(make([]int8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]int8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]int8, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectInt8s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Int8 ----------------------------------
+
+func TestInt8_Div(t *testing.T) {
+	rangeTiers(t, testInt8Div)
+}
+
+func testInt8Div(t *testing.T) {
+	top := int8(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []int8{0, 1, 2, 3, top, top + 1, top - 1, ^int8(0), ^int8(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, int8(x^x>>29), int8(x>>59), int8(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]int8, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarInt8s(make([]int8, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]int8, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivInt8s(make([]int8, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]int8, len(divisors)), divisors, value), RevDivScalarInt8s(make([]int8, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]int8, 100), makeVector[int8](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivInt8s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarInt8s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarInt8s(make([]int8, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]int8, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarInt8s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Int16 ----------------------------------
+
+func BenchmarkInt16(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int16"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[int16](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt16sToInt64(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = MinInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := int16(0), int16(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxInt16s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinInt16s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt16s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]int16, count)
+		input1 := makeVector[int16](count)
+		input2 := makeVector[int16](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = AddInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = SubInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = MulInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = DivInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = MinOfInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = MaxOfInt16s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = ClampInt16s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsInt16s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenInt16s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareInt16s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanInt16s(input1, 30, make([]uint64, count/64))
+			var result int16
+			for i := 0; i < b.N; i++ {
+				result = SumInt16sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanInt16s(input1, 30, make([]uint64, count/64))
+			var result int16
+			for i := 0; i < b.N; i++ {
+				result, _ = MinInt16sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanInt16s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressInt16s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanInt16s(input1, 30, make([]uint64, count/64))
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = SelectInt16s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = AddScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = SubScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = MulScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = DivScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarInt16s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkInt16_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int16"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[int16](count)
+		output := make([]int16, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt16s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int16(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt16s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int16
+			for i := 0; i < b.N; i++ {
+				result = AddInt16s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Int16 ----------------------------------
+
+func TestInt16_Ops(t *testing.T) {
+	rangeTiers(t, testInt16Ops)
+}
+
+func testInt16Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[int16](70)
+		expect := sum(input)
+		result := SumInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int16](1000)
+		expect := sumWide[int64](input)
+		result := SumInt16sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int16](70)
+		expect := min(input)
+		result := MinInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int16](70)
+		expect := max(input)
+		result := MaxInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int16](70)
+		lo, hi := MinMaxInt16s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int16](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt16s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int16](1000)
+		expect := argmax(input)
+		result := ArgMaxInt16s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := dot(input1, input2)
+		result := DotInt16s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := add(make([]int16, 70), input1, input2)
+		result := AddInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := sub(make([]int16, 70), input1, input2)
+		result := SubInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := mul(make([]int16, 70), input1, input2)
+		result := MulInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := div(make([]int16, 70), input1, input2)
+		result := DivInt16s(make([]int16, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int16](70)
+		expect := addScalar(make([]int16, 70), input, 2)
+		result := AddScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int16](70)
+		expect := subScalar(make([]int16, 70), input, 2)
+		result := SubScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int16](70)
+		expect := rsubScalar(make([]int16, 70), input, 2)
+		result := RevSubScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int16](70)
+		expect := mulScalar(make([]int16, 70), input, 2)
+		result := MulScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int16](70)
+		expect := divScalar(make([]int16, 70), input, 2)
+		result := DivScalarInt16s(make([]int16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int16](70)
+		expect := rdivScalar(make([]int16, 70), input, 2)
+		result := RevDivScalarInt16s(make([]int16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](90)[20:]
+		expect := minOf(make([]int16, 70), input1, input2)
+		result := MinOfInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](90)[20:]
+		expect := maxOf(make([]int16, 70), input1, input2)
+		result := MaxOfInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int16](70)
+		expect := minOfScalar(make([]int16, 70), input, 50)
+		result := MinOfScalarInt16s(make([]int16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int16](70)
+		expect := maxOfScalar(make([]int16, 70), input, 50)
+		result := MaxOfScalarInt16s(make([]int16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int16](70)
+		expect := clamp(make([]int16, 70), input, 20, 60)
+		result := ClampInt16s(make([]int16, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int16, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int16, 70), input, 60, 20), ClampInt16s(make([]int16, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Int16 ----------------------------------
+
+func TestInt16_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[int16](70)
+		expect := sum(input)
+		result := SumInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int16](1000)
+		expect := sumWide[int64](input)
+		result := SumInt16sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int16](70)
+		expect := min(input)
+		result := MinInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int16](70)
+		expect := max(input)
+		result := MaxInt16s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int16](70)
+		lo, hi := MinMaxInt16s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int16](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt16s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int16](1000)
+		expect := argmax(input)
+		result := ArgMaxInt16s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := dot(input1, input2)
+		result := DotInt16s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := add(make([]int16, 70), input1, input2)
+		result := AddInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := sub(make([]int16, 70), input1, input2)
+		result := SubInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := mul(make([]int16, 70), input1, input2)
+		result := MulInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](70)
+		expect := div(make([]int16, 70), input1, input2)
+		result := DivInt16s(make([]int16, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int16](70)
+		expect := addScalar(make([]int16, 70), input, 2)
+		result := AddScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int16](70)
+		expect := subScalar(make([]int16, 70), input, 2)
+		result := SubScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int16](70)
+		expect := rsubScalar(make([]int16, 70), input, 2)
+		result := RevSubScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int16](70)
+		expect := mulScalar(make([]int16, 70), input, 2)
+		result := MulScalarInt16s(make([]int16, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int16](70)
+		expect := divScalar(make([]int16, 70), input, 2)
+		result := DivScalarInt16s(make([]int16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int16](70)
+		expect := rdivScalar(make([]int16, 70), input, 2)
+		result := RevDivScalarInt16s(make([]int16, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](90)[20:]
+		expect := minOf(make([]int16, 70), input1, input2)
+		result := MinOfInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int16](70)
+		input2 := makeVector[int16](90)[20:]
+		expect := maxOf(make([]int16, 70), input1, input2)
+		result := MaxOfInt16s(make([]int16, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int16](70)
+		expect := minOfScalar(make([]int16, 70), input, 50)
+		result := MinOfScalarInt16s(make([]int16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int16](70)
+		expect := maxOfScalar(make([]int16, 70), input, 50)
+		result := MaxOfScalarInt16s(make([]int16, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int16](70)
+		expect := clamp(make([]int16, 70), input, 20, 60)
+		result := ClampInt16s(make([]int16, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int16, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int16, 70), input, 60, 20), ClampInt16s(make([]int16, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Int16 ----------------------------------
+
+func TestInt16_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []int16{}
+		assert.Zero(t, SumInt16s(nil), mode)
+		assert.Zero(t, SumInt16s(empty), mode)
+		assert.Zero(t, DotInt16s(empty, makeVector[int16](70)), mode)
+		assert.Zero(t, SumInt16sToInt64(empty), mode)
+		assert.Panics(t, func() { MinInt16s(empty) }, mode)
+		assert.Panics(t, func() { MaxInt16s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxInt16s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinInt16s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxInt16s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[int16](70), makeVector[int16](33)
+		assert.EqualValues(t, dot(short, short), DotInt16s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotInt16s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]int16, n[0]), makeVector[int16](n[1]), makeVector[int16](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]int16, size), input1, input2), AddInt16s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]int16, size), input1, input2), SubInt16s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]int16, size), input1, input2), MulInt16s(dst, input1, input2), mode)
+			assert.Len(t, DivInt16s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]int16, size), input1, input2), MinOfInt16s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]int16, size), input1, input2), MaxOfInt16s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarInt16s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampInt16s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Int16 ----------------------------------
+
+func TestInt16_Compare(t *testing.T) {
+	rangeTiers(t, testInt16Compare)
+}
+
+func testInt16Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int16](size)
+		words := (size + 63) / 64
+		for _, value := range []int16{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsInt16s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsInt16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanInt16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanInt16s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenInt16s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsInt16s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanInt16s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanInt16s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenInt16s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenInt16s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[int16](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareInt16s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareInt16s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareInt16s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareInt16s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareInt16s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareInt16s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Int16 ----------------------------------
+
+func TestInt16_Masked(t *testing.T) {
+	rangeTiers(t, testInt16Masked)
+}
+
+func testInt16Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int16](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanInt16s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []int16
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumInt16sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumInt16sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumInt16sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinInt16sMasked(input, mask)
+			hi, okHi := MaxInt16sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]int16, capacity+1), make([]int16, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressInt16s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]int16{}, selected[:count]...), append([]int16{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]int16, size), int16(7)
+			for i := range other {
+				other[i] = int16(i % 13)
+			}
+
+			expect, expectScalar := make([]int16, 0, size), make([]int16, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectInt16s(make([]int16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]int16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]int16, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectInt16s(make([]int16, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarInt16s(make([]int16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]int16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]int16, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectInt16s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Int16 ----------------------------------
+
+func TestInt16_Div(t *testing.T) {
+	rangeTiers(t, testInt16Div)
+}
+
+func testInt16Div(t *testing.T) {
+	top := int16(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []int16{0, 1, 2, 3, top, top + 1, top - 1, ^int16(0), ^int16(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, int16(x^x>>29), int16(x>>59), int16(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]int16, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarInt16s(make([]int16, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]int16, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivInt16s(make([]int16, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]int16, len(divisors)), divisors, value), RevDivScalarInt16s(make([]int16, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]int16, 100), makeVector[int16](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivInt16s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarInt16s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarInt16s(make([]int16, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]int16, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarInt16s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Int32 ----------------------------------
+
+func BenchmarkInt32(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int32"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[int32](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt32sToInt64(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = MinInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := int32(0), int32(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxInt32s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinInt32s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]int32, count)
+		input1 := makeVector[int32](count)
+		input2 := makeVector[int32](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = AddInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = SubInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = MulInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = DivInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = MinOfInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = MaxOfInt32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = ClampInt32s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsInt32s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenInt32s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareInt32s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanInt32s(input1, 30, make([]uint64, count/64))
+			var result int32
+			for i := 0; i < b.N; i++ {
+				result = SumInt32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanInt32s(input1, 30, make([]uint64, count/64))
+			var result int32
+			for i := 0; i < b.N; i++ {
+				result, _ = MinInt32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanInt32s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressInt32s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanInt32s(input1, 30, make([]uint64, count/64))
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = SelectInt32s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = AddScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = SubScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = MulScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = DivScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarInt32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkInt32_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int32"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[int32](count)
+		output := make([]int32, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int32(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int32
+			for i := 0; i < b.N; i++ {
+				result = AddInt32s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Int32 ----------------------------------
+
+func TestInt32_Ops(t *testing.T) {
+	rangeTiers(t, testInt32Ops)
+}
+
+func testInt32Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[int32](70)
+		expect := sum(input)
+		result := SumInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int32](1000)
+		expect := sumWide[int64](input)
+		result := SumInt32sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int32](70)
+		expect := min(input)
+		result := MinInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int32](70)
+		expect := max(input)
+		result := MaxInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int32](70)
+		lo, hi := MinMaxInt32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int32](1000)
+		expect := argmax(input)
+		result := ArgMaxInt32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := dot(input1, input2)
+		result := DotInt32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := add(make([]int32, 70), input1, input2)
+		result := AddInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := sub(make([]int32, 70), input1, input2)
+		result := SubInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := mul(make([]int32, 70), input1, input2)
+		result := MulInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := div(make([]int32, 70), input1, input2)
+		result := DivInt32s(make([]int32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int32](70)
+		expect := addScalar(make([]int32, 70), input, 2)
+		result := AddScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int32](70)
+		expect := subScalar(make([]int32, 70), input, 2)
+		result := SubScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int32](70)
+		expect := rsubScalar(make([]int32, 70), input, 2)
+		result := RevSubScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int32](70)
+		expect := mulScalar(make([]int32, 70), input, 2)
+		result := MulScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int32](70)
+		expect := divScalar(make([]int32, 70), input, 2)
+		result := DivScalarInt32s(make([]int32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int32](70)
+		expect := rdivScalar(make([]int32, 70), input, 2)
+		result := RevDivScalarInt32s(make([]int32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](90)[20:]
+		expect := minOf(make([]int32, 70), input1, input2)
+		result := MinOfInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](90)[20:]
+		expect := maxOf(make([]int32, 70), input1, input2)
+		result := MaxOfInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int32](70)
+		expect := minOfScalar(make([]int32, 70), input, 50)
+		result := MinOfScalarInt32s(make([]int32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int32](70)
+		expect := maxOfScalar(make([]int32, 70), input, 50)
+		result := MaxOfScalarInt32s(make([]int32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int32](70)
+		expect := clamp(make([]int32, 70), input, 20, 60)
+		result := ClampInt32s(make([]int32, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int32, 70), input, 60, 20), ClampInt32s(make([]int32, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Int32 ----------------------------------
+
+func TestInt32_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[int32](70)
+		expect := sum(input)
+		result := SumInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[int32](1000)
+		expect := sumWide[int64](input)
+		result := SumInt32sToInt64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int32](70)
+		expect := min(input)
+		result := MinInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int32](70)
+		expect := max(input)
+		result := MaxInt32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int32](70)
+		lo, hi := MinMaxInt32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int32](1000)
+		expect := argmax(input)
+		result := ArgMaxInt32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := dot(input1, input2)
+		result := DotInt32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := add(make([]int32, 70), input1, input2)
+		result := AddInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := sub(make([]int32, 70), input1, input2)
+		result := SubInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := mul(make([]int32, 70), input1, input2)
+		result := MulInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](70)
+		expect := div(make([]int32, 70), input1, input2)
+		result := DivInt32s(make([]int32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int32](70)
+		expect := addScalar(make([]int32, 70), input, 2)
+		result := AddScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int32](70)
+		expect := subScalar(make([]int32, 70), input, 2)
+		result := SubScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int32](70)
+		expect := rsubScalar(make([]int32, 70), input, 2)
+		result := RevSubScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int32](70)
+		expect := mulScalar(make([]int32, 70), input, 2)
+		result := MulScalarInt32s(make([]int32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int32](70)
+		expect := divScalar(make([]int32, 70), input, 2)
+		result := DivScalarInt32s(make([]int32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int32](70)
+		expect := rdivScalar(make([]int32, 70), input, 2)
+		result := RevDivScalarInt32s(make([]int32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](90)[20:]
+		expect := minOf(make([]int32, 70), input1, input2)
+		result := MinOfInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int32](70)
+		input2 := makeVector[int32](90)[20:]
+		expect := maxOf(make([]int32, 70), input1, input2)
+		result := MaxOfInt32s(make([]int32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int32](70)
+		expect := minOfScalar(make([]int32, 70), input, 50)
+		result := MinOfScalarInt32s(make([]int32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int32](70)
+		expect := maxOfScalar(make([]int32, 70), input, 50)
+		result := MaxOfScalarInt32s(make([]int32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int32](70)
+		expect := clamp(make([]int32, 70), input, 20, 60)
+		result := ClampInt32s(make([]int32, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int32, 70), input, 60, 20), ClampInt32s(make([]int32, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Int32 ----------------------------------
+
+func TestInt32_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []int32{}
+		assert.Zero(t, SumInt32s(nil), mode)
+		assert.Zero(t, SumInt32s(empty), mode)
+		assert.Zero(t, DotInt32s(empty, makeVector[int32](70)), mode)
+		assert.Zero(t, SumInt32sToInt64(empty), mode)
+		assert.Panics(t, func() { MinInt32s(empty) }, mode)
+		assert.Panics(t, func() { MaxInt32s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxInt32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinInt32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxInt32s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[int32](70), makeVector[int32](33)
+		assert.EqualValues(t, dot(short, short), DotInt32s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotInt32s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]int32, n[0]), makeVector[int32](n[1]), makeVector[int32](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]int32, size), input1, input2), AddInt32s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]int32, size), input1, input2), SubInt32s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]int32, size), input1, input2), MulInt32s(dst, input1, input2), mode)
+			assert.Len(t, DivInt32s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]int32, size), input1, input2), MinOfInt32s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]int32, size), input1, input2), MaxOfInt32s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarInt32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampInt32s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Int32 ----------------------------------
+
+func TestInt32_Compare(t *testing.T) {
+	rangeTiers(t, testInt32Compare)
+}
+
+func testInt32Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int32](size)
+		words := (size + 63) / 64
+		for _, value := range []int32{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsInt32s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsInt32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanInt32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanInt32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenInt32s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsInt32s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanInt32s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanInt32s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenInt32s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenInt32s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[int32](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareInt32s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareInt32s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareInt32s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareInt32s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareInt32s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareInt32s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Int32 ----------------------------------
+
+func TestInt32_Masked(t *testing.T) {
+	rangeTiers(t, testInt32Masked)
+}
+
+func testInt32Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int32](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanInt32s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []int32
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumInt32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumInt32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumInt32sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinInt32sMasked(input, mask)
+			hi, okHi := MaxInt32sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]int32, capacity+1), make([]int32, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressInt32s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]int32{}, selected[:count]...), append([]int32{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]int32, size), int32(7)
+			for i := range other {
+				other[i] = int32(i % 13)
+			}
+
+			expect, expectScalar := make([]int32, 0, size), make([]int32, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectInt32s(make([]int32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]int32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]int32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectInt32s(make([]int32, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarInt32s(make([]int32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]int32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]int32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectInt32s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Int32 ----------------------------------
+
+func TestInt32_Div(t *testing.T) {
+	rangeTiers(t, testInt32Div)
+}
+
+func testInt32Div(t *testing.T) {
+	top := int32(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []int32{0, 1, 2, 3, top, top + 1, top - 1, ^int32(0), ^int32(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, int32(x^x>>29), int32(x>>59), int32(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]int32, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarInt32s(make([]int32, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]int32, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivInt32s(make([]int32, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]int32, len(divisors)), divisors, value), RevDivScalarInt32s(make([]int32, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]int32, 100), makeVector[int32](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivInt32s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarInt32s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarInt32s(make([]int32, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]int32, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarInt32s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Int64 ----------------------------------
+
+func BenchmarkInt64(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int64"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[int64](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = MinInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := int64(0), int64(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxInt64s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinInt64s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt64s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]int64, count)
+		input1 := makeVector[int64](count)
+		input2 := makeVector[int64](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = AddInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = SubInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = MulInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = DivInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = MinOfInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = MaxOfInt64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = ClampInt64s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsInt64s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenInt64s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareInt64s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanInt64s(input1, 30, make([]uint64, count/64))
+			var result int64
+			for i := 0; i < b.N; i++ {
+				result = SumInt64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanInt64s(input1, 30, make([]uint64, count/64))
+			var result int64
+			for i := 0; i < b.N; i++ {
+				result, _ = MinInt64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanInt64s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressInt64s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanInt64s(input1, 30, make([]uint64, count/64))
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = SelectInt64s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = AddScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = SubScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = MulScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = DivScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarInt64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+func BenchmarkInt64_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "int64"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[int64](count)
+		output := make([]int64, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxInt64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := int64(0)
+			for i := 0; i < b.N; i++ {
+				result = DotInt64s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []int64
+			for i := 0; i < b.N; i++ {
+				result = AddInt64s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Int64 ----------------------------------
+
+func TestInt64_Ops(t *testing.T) {
+	rangeTiers(t, testInt64Ops)
+}
+
+func testInt64Ops(t *testing.T) {
+	{ // Sum
+		input := makeVector[int64](70)
+		expect := sum(input)
+		result := SumInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int64](70)
+		expect := min(input)
+		result := MinInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int64](70)
+		expect := max(input)
+		result := MaxInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int64](70)
+		lo, hi := MinMaxInt64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int64](1000)
+		expect := argmax(input)
+		result := ArgMaxInt64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := dot(input1, input2)
+		result := DotInt64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := add(make([]int64, 70), input1, input2)
+		result := AddInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := sub(make([]int64, 70), input1, input2)
+		result := SubInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := mul(make([]int64, 70), input1, input2)
+		result := MulInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := div(make([]int64, 70), input1, input2)
+		result := DivInt64s(make([]int64, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int64](70)
+		expect := addScalar(make([]int64, 70), input, 2)
+		result := AddScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int64](70)
+		expect := subScalar(make([]int64, 70), input, 2)
+		result := SubScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int64](70)
+		expect := rsubScalar(make([]int64, 70), input, 2)
+		result := RevSubScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int64](70)
+		expect := mulScalar(make([]int64, 70), input, 2)
+		result := MulScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int64](70)
+		expect := divScalar(make([]int64, 70), input, 2)
+		result := DivScalarInt64s(make([]int64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int64](70)
+		expect := rdivScalar(make([]int64, 70), input, 2)
+		result := RevDivScalarInt64s(make([]int64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](90)[20:]
+		expect := minOf(make([]int64, 70), input1, input2)
+		result := MinOfInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](90)[20:]
+		expect := maxOf(make([]int64, 70), input1, input2)
+		result := MaxOfInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int64](70)
+		expect := minOfScalar(make([]int64, 70), input, 50)
+		result := MinOfScalarInt64s(make([]int64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int64](70)
+		expect := maxOfScalar(make([]int64, 70), input, 50)
+		result := MaxOfScalarInt64s(make([]int64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int64](70)
+		expect := clamp(make([]int64, 70), input, 20, 60)
+		result := ClampInt64s(make([]int64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int64, 70), input, 60, 20), ClampInt64s(make([]int64, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Fallback Int64 ----------------------------------
+
+func TestInt64_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[int64](70)
+		expect := sum(input)
+		result := SumInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[int64](70)
+		expect := min(input)
+		result := MinInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[int64](70)
+		expect := max(input)
+		result := MaxInt64s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[int64](70)
+		lo, hi := MinMaxInt64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[int64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinInt64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[int64](1000)
+		expect := argmax(input)
+		result := ArgMaxInt64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := dot(input1, input2)
+		result := DotInt64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := add(make([]int64, 70), input1, input2)
+		result := AddInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := sub(make([]int64, 70), input1, input2)
+		result := SubInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := mul(make([]int64, 70), input1, input2)
+		result := MulInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](70)
+		expect := div(make([]int64, 70), input1, input2)
+		result := DivInt64s(make([]int64, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[int64](70)
+		expect := addScalar(make([]int64, 70), input, 2)
+		result := AddScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[int64](70)
+		expect := subScalar(make([]int64, 70), input, 2)
+		result := SubScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[int64](70)
+		expect := rsubScalar(make([]int64, 70), input, 2)
+		result := RevSubScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[int64](70)
+		expect := mulScalar(make([]int64, 70), input, 2)
+		result := MulScalarInt64s(make([]int64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[int64](70)
+		expect := divScalar(make([]int64, 70), input, 2)
+		result := DivScalarInt64s(make([]int64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[int64](70)
+		expect := rdivScalar(make([]int64, 70), input, 2)
+		result := RevDivScalarInt64s(make([]int64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](90)[20:]
+		expect := minOf(make([]int64, 70), input1, input2)
+		result := MinOfInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[int64](70)
+		input2 := makeVector[int64](90)[20:]
+		expect := maxOf(make([]int64, 70), input1, input2)
+		result := MaxOfInt64s(make([]int64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[int64](70)
+		expect := minOfScalar(make([]int64, 70), input, 50)
+		result := MinOfScalarInt64s(make([]int64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[int64](70)
+		expect := maxOfScalar(make([]int64, 70), input, 50)
+		result := MaxOfScalarInt64s(make([]int64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[int64](70)
+		expect := clamp(make([]int64, 70), input, 20, 60)
+		result := ClampInt64s(make([]int64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]int64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]int64, 70), input, 60, 20), ClampInt64s(make([]int64, 70), input, 60, 20))
+	}
+}
+
+// ---------------------------------- Test Bounds Int64 ----------------------------------
+
+func TestInt64_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []int64{}
+		assert.Zero(t, SumInt64s(nil), mode)
+		assert.Zero(t, SumInt64s(empty), mode)
+		assert.Zero(t, DotInt64s(empty, makeVector[int64](70)), mode)
+		assert.Panics(t, func() { MinInt64s(empty) }, mode)
+		assert.Panics(t, func() { MaxInt64s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxInt64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinInt64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxInt64s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[int64](70), makeVector[int64](33)
+		assert.EqualValues(t, dot(short, short), DotInt64s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotInt64s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]int64, n[0]), makeVector[int64](n[1]), makeVector[int64](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]int64, size), input1, input2), AddInt64s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]int64, size), input1, input2), SubInt64s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]int64, size), input1, input2), MulInt64s(dst, input1, input2), mode)
+			assert.Len(t, DivInt64s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]int64, size), input1, input2), MinOfInt64s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]int64, size), input1, input2), MaxOfInt64s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarInt64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampInt64s(dst, input1, 2, 5), shortest(dst, input1), mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Int64 ----------------------------------
+
+func TestInt64_Compare(t *testing.T) {
+	rangeTiers(t, testInt64Compare)
+}
+
+func testInt64Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int64](size)
+		words := (size + 63) / 64
+		for _, value := range []int64{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsInt64s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsInt64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanInt64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanInt64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenInt64s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsInt64s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanInt64s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanInt64s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenInt64s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenInt64s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[int64](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareInt64s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareInt64s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareInt64s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareInt64s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareInt64s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareInt64s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Int64 ----------------------------------
+
+func TestInt64_Masked(t *testing.T) {
+	rangeTiers(t, testInt64Masked)
+}
+
+func testInt64Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[int64](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanInt64s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []int64
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumInt64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumInt64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumInt64sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinInt64sMasked(input, mask)
+			hi, okHi := MaxInt64sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]int64, capacity+1), make([]int64, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressInt64s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]int64{}, selected[:count]...), append([]int64{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]int64, size), int64(7)
+			for i := range other {
+				other[i] = int64(i % 13)
+			}
+
+			expect, expectScalar := make([]int64, 0, size), make([]int64, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectInt64s(make([]int64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]int64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]int64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectInt64s(make([]int64, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarInt64s(make([]int64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]int64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]int64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectInt64s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+
+// ---------------------------------- Test Div Int64 ----------------------------------
+
+func TestInt64_Div(t *testing.T) {
+	rangeTiers(t, testInt64Div)
+}
+
+func testInt64Div(t *testing.T) {
+	top := int64(1) // the top bit, which is also the smallest signed value
+	for top<<1 != 0 {
+		top <<= 1
+	}
+
+	// Pseudo-random values over the full range, along with the extremes
+	input := []int64{0, 1, 2, 3, top, top + 1, top - 1, ^int64(0), ^int64(0) - 1}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, int64(x^x>>29), int64(x>>59), int64(x>>53))
+	}
+
+	for _, value := range input {
+		if value == 0 {
+			continue
+		}
+
+		expect := make([]int64, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		assert.Equal(t, expect, DivScalarInt64s(make([]int64, len(input)), input, value), "value=%d", value)
+
+		divisors := make([]int64, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		assert.Equal(t, expect, DivInt64s(make([]int64, len(input)), input, divisors), "value=%d", value)
+
+		for i, v := range divisors {
+			expect[i] = input[i] / v
+		}
+		assert.Equal(t, rdivScalar(make([]int64, len(divisors)), divisors, value), RevDivScalarInt64s(make([]int64, len(divisors)), divisors, value), "value=%d", value)
+	}
+
+	// Division by zero panics with the index of the first zero divisor
+	for _, at := range []int{0, 1, 7, 33, 99} {
+		output, divisors := make([]int64, 100), makeVector[int64](100)
+		divisors[at] = 0
+		expect := (&DivideByZeroError{Index: at}).Error()
+		assert.PanicsWithError(t, expect, func() { DivInt64s(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { RevDivScalarInt64s(output, divisors, 1) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { div(output, divisors, divisors) }, "at=%d", at)
+		assert.PanicsWithError(t, expect, func() { rdivScalar(output, divisors, 1) }, "at=%d", at)
+	}
+
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { DivScalarInt64s(make([]int64, 10), input, 0) })
+	assert.PanicsWithError(t, (&DivideByZeroError{}).Error(), func() { divScalar(make([]int64, 10), input, 0) })
+	assert.NotPanics(t, func() { DivScalarInt64s(nil, input, 0) })
+}
+
+
+// ---------------------------------- Benchmark Float32 ----------------------------------
+
+func BenchmarkFloat32(b *testing.B) {
+	result := make([]Result, 0, 64)
+
+	typ := "float32"
+	for _, count := range []int{256, 4096, 16384} {
+		vector := makeVector[float32](count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "wsum", count, func(b *testing.B) {
+			result := float64(0)
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32sToFloat64(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = MinFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := float32(0), float32(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxFloat32s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinFloat32s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = DotFloat32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]float32, count)
+		input1 := makeVector[float32](count)
+		input2 := makeVector[float32](count)
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = AddFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = SubFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = MulFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = DivFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = MinOfFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = MaxOfFloat32s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = ClampFloat32s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsFloat32s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenFloat32s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareFloat32s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanFloat32s(input1, 30, make([]uint64, count/64))
+			var result float32
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanFloat32s(input1, 30, make([]uint64, count/64))
+			var result float32
+			for i := 0; i < b.N; i++ {
+				result, _ = MinFloat32sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanFloat32s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressFloat32s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanFloat32s(input1, 30, make([]uint64, count/64))
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = SelectFloat32s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = AddScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = SubScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = MulScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = DivScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarFloat32s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "ksum", count, func(b *testing.B) {
+			var result float32
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32sKahan(input1)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "psum", count, func(b *testing.B) {
+			var result float32
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32sPairwise(input1)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "fma", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = FMAFloat32s(output, input1, input2, input1)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "axpy", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = AXPYFloat32s(output, 2, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
@@ -1271,527 +7837,1233 @@ func BenchmarkInt32(b *testing.B) {
 	}
 }
 
-// ---------------------------------- Test Int32 ----------------------------------
+func BenchmarkFloat32_Small(b *testing.B) {
+	result := make([]Result, 0, 64)
 
-func TestInt32_Ops(t *testing.T) {
+	typ := "float32"
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
+		vector := makeVector[float32](count)
+		output := make([]float32, count)
+		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = SumFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = MaxFloat32s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := float32(0)
+			for i := 0; i < b.N; i++ {
+				result = DotFloat32s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
+			var result []float32
+			for i := 0; i < b.N; i++ {
+				result = AddFloat32s(output, vector, vector)
+			}
+			assert.NotEmpty(b, result)
+		}))
+	}
+
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
+	}
+}
+
+// ---------------------------------- Test Float32 ----------------------------------
+
+func TestFloat32_Ops(t *testing.T) {
+	rangeTiers(t, testFloat32Ops)
+}
+
+func testFloat32Ops(t *testing.T) {
 	{ // Sum
-		input := makeVector[int32](70)
+		input := makeVector[float32](70)
 		expect := sum(input)
-		result := SumInt32s(input)
+		result := SumFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[float32](1000)
+		expect := sumWide[float64](input)
+		result := SumFloat32sToFloat64(input)
+		assert.EqualValues(t, 50500, result)
 		assert.EqualValues(t, expect, result)
 	}
 
 	{ // Min
-		input := makeVector[int32](70)
+		input := makeVector[float32](70)
 		expect := min(input)
-		result := MinInt32s(input)
+		result := MinFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[float32](70)
+		expect := max(input)
+		result := MaxFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[float32](70)
+		lo, hi := MinMaxFloat32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[float32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinFloat32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[float32](1000)
+		expect := argmax(input)
+		result := ArgMaxFloat32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := dot(input1, input2)
+		result := DotFloat32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := add(make([]float32, 70), input1, input2)
+		result := AddFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := sub(make([]float32, 70), input1, input2)
+		result := SubFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := mul(make([]float32, 70), input1, input2)
+		result := MulFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Div
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := div(make([]float32, 70), input1, input2)
+		result := DivFloat32s(make([]float32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[float32](70)
+		expect := addScalar(make([]float32, 70), input, 2)
+		result := AddScalarFloat32s(make([]float32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[float32](70)
+		expect := subScalar(make([]float32, 70), input, 2)
+		result := SubScalarFloat32s(make([]float32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[float32](70)
+		expect := rsubScalar(make([]float32, 70), input, 2)
+		result := RevSubScalarFloat32s(make([]float32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[float32](70)
+		expect := mulScalar(make([]float32, 70), input, 2)
+		result := MulScalarFloat32s(make([]float32, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[float32](70)
+		expect := divScalar(make([]float32, 70), input, 2)
+		result := DivScalarFloat32s(make([]float32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[float32](70)
+		expect := rdivScalar(make([]float32, 70), input, 2)
+		result := RevDivScalarFloat32s(make([]float32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](90)[20:]
+		expect := minOf(make([]float32, 70), input1, input2)
+		result := MinOfFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](90)[20:]
+		expect := maxOf(make([]float32, 70), input1, input2)
+		result := MaxOfFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[float32](70)
+		expect := minOfScalar(make([]float32, 70), input, 50)
+		result := MinOfScalarFloat32s(make([]float32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[float32](70)
+		expect := maxOfScalar(make([]float32, 70), input, 50)
+		result := MaxOfScalarFloat32s(make([]float32, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[float32](70)
+		expect := clamp(make([]float32, 70), input, 20, 60)
+		result := ClampFloat32s(make([]float32, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]float32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]float32, 70), input, 60, 20), ClampFloat32s(make([]float32, 70), input, 60, 20))
+	}
+
+	{ // FMA
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		input3 := makeVector[float32](70)
+		expect := fmadd(make([]float32, 70), input1, input2, input3)
+		result := FMAFloat32s(make([]float32, 70), input1, input2, input3)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AXPY
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := axpy(make([]float32, 70), 2, input1, input2)
+		result := AXPYFloat32s(make([]float32, 70), 2, input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+}
+
+// ---------------------------------- Test Fallback Float32 ----------------------------------
+
+func TestFloat32_Fallback_Sum(t *testing.T) {
+	defer SetLevel(Features())
+	SetLevel(Generic)
+
+	{ // Sum
+		input := makeVector[float32](70)
+		expect := sum(input)
+		result := SumFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sum (wide)
+		input := makeVector[float32](1000)
+		expect := sumWide[float64](input)
+		result := SumFloat32sToFloat64(input)
+		assert.EqualValues(t, 50500, result)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Min
+		input := makeVector[float32](70)
+		expect := min(input)
+		result := MinFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Max
+		input := makeVector[float32](70)
+		expect := max(input)
+		result := MaxFloat32s(input)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinMax
+		input := makeVector[float32](70)
+		lo, hi := MinMaxFloat32s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[float32](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinFloat32s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[float32](1000)
+		expect := argmax(input)
+		result := ArgMaxFloat32s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := dot(input1, input2)
+		result := DotFloat32s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Add
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := add(make([]float32, 70), input1, input2)
+		result := AddFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Sub
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := sub(make([]float32, 70), input1, input2)
+		result := SubFloat32s(make([]float32, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Mul
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := mul(make([]float32, 70), input1, input2)
+		result := MulFloat32s(make([]float32, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Max
-		input := makeVector[int32](70)
-		expect := max(input)
-		result := MaxInt32s(input)
+	{ // Div
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := div(make([]float32, 70), input1, input2)
+		result := DivFloat32s(make([]float32, 70), input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AddScalar
+		input := makeVector[float32](70)
+		expect := addScalar(make([]float32, 70), input, 2)
+		result := AddScalarFloat32s(make([]float32, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := add(make([]int32, 70), input1, input2)
-		result := AddInt32s(make([]int32, 70), input1, input2)
+	{ // SubScalar
+		input := makeVector[float32](70)
+		expect := subScalar(make([]float32, 70), input, 2)
+		result := SubScalarFloat32s(make([]float32, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Sub
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := sub(make([]int32, 70), input1, input2)
-		result := SubInt32s(make([]int32, 70), input1, input2)
+	{ // RevSubScalar
+		input := makeVector[float32](70)
+		expect := rsubScalar(make([]float32, 70), input, 2)
+		result := RevSubScalarFloat32s(make([]float32, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Mul
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := mul(make([]int32, 70), input1, input2)
-		result := MulInt32s(make([]int32, 70), input1, input2)
+	{ // MulScalar
+		input := makeVector[float32](70)
+		expect := mulScalar(make([]float32, 70), input, 2)
+		result := MulScalarFloat32s(make([]float32, 70), input, 2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Div
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := div(make([]int32, 70), input1, input2)
-		result := DivInt32s(make([]int32, 70), input1, input2)
+	{ // DivScalar
+		input := makeVector[float32](70)
+		expect := divScalar(make([]float32, 70), input, 2)
+		result := DivScalarFloat32s(make([]float32, 70), input, 2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
-}
 
-// ---------------------------------- Test Fallback Int32 ----------------------------------
-
-func TestInt32_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+	{ // RevDivScalar
+		input := makeVector[float32](70)
+		expect := rdivScalar(make([]float32, 70), input, 2)
+		result := RevDivScalarFloat32s(make([]float32, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
 
-	{ // Sum
-		input := makeVector[int32](70)
-		expect := sum(input)
-		result := SumInt32s(input)
+	{ // MinOf
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](90)[20:]
+		expect := minOf(make([]float32, 70), input1, input2)
+		result := MinOfFloat32s(make([]float32, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Min
-		input := makeVector[int32](70)
-		expect := min(input)
-		result := MinInt32s(input)
+	{ // MaxOf
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](90)[20:]
+		expect := maxOf(make([]float32, 70), input1, input2)
+		result := MaxOfFloat32s(make([]float32, 70), input1, input2)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Max
-		input := makeVector[int32](70)
-		expect := max(input)
-		result := MaxInt32s(input)
+	{ // MinOfScalar
+		input := makeVector[float32](70)
+		expect := minOfScalar(make([]float32, 70), input, 50)
+		result := MinOfScalarFloat32s(make([]float32, 70), input, 50)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Add
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := add(make([]int32, 70), input1, input2)
-		result := AddInt32s(make([]int32, 70), input1, input2)
+	{ // MaxOfScalar
+		input := makeVector[float32](70)
+		expect := maxOfScalar(make([]float32, 70), input, 50)
+		result := MaxOfScalarFloat32s(make([]float32, 70), input, 50)
 		assert.EqualValues(t, expect, result)
 	}
 
-	{ // Sub
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := sub(make([]int32, 70), input1, input2)
-		result := SubInt32s(make([]int32, 70), input1, input2)
+	{ // Clamp
+		input := makeVector[float32](70)
+		expect := clamp(make([]float32, 70), input, 20, 60)
+		result := ClampFloat32s(make([]float32, 70), input, 20, 60)
 		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]float32, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]float32, 70), input, 60, 20), ClampFloat32s(make([]float32, 70), input, 60, 20))
 	}
 
-	{ // Mul
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := mul(make([]int32, 70), input1, input2)
-		result := MulInt32s(make([]int32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	{ // FMA
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		input3 := makeVector[float32](70)
+		expect := fmadd(make([]float32, 70), input1, input2, input3)
+		result := FMAFloat32s(make([]float32, 70), input1, input2, input3)
+		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
 
-	{ // Div
-		input1 := makeVector[int32](70)
-		input2 := makeVector[int32](70)
-		expect := div(make([]int32, 70), input1, input2)
-		result := DivInt32s(make([]int32, 70), input1, input2)
+	{ // AXPY
+		input1 := makeVector[float32](70)
+		input2 := makeVector[float32](70)
+		expect := axpy(make([]float32, 70), 2, input1, input2)
+		result := AXPYFloat32s(make([]float32, 70), 2, input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
 }
 
-// ---------------------------------- Benchmark Int64 ----------------------------------
+// ---------------------------------- Test Bounds Float32 ----------------------------------
+
+func TestFloat32_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []float32{}
+		assert.Zero(t, SumFloat32s(nil), mode)
+		assert.Zero(t, SumFloat32s(empty), mode)
+		assert.Zero(t, DotFloat32s(empty, makeVector[float32](70)), mode)
+		assert.Zero(t, SumFloat32sToFloat64(empty), mode)
+		assert.Panics(t, func() { MinFloat32s(empty) }, mode)
+		assert.Panics(t, func() { MaxFloat32s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxFloat32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinFloat32s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxFloat32s(empty) }, mode)
+		assert.Panics(t, func() { NanMinFloat32s(empty) }, mode)
+		assert.Panics(t, func() { NanMaxFloat32s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[float32](70), makeVector[float32](33)
+		assert.EqualValues(t, dot(short, short), DotFloat32s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotFloat32s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]float32, n[0]), makeVector[float32](n[1]), makeVector[float32](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]float32, size), input1, input2), AddFloat32s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]float32, size), input1, input2), SubFloat32s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]float32, size), input1, input2), MulFloat32s(dst, input1, input2), mode)
+			assert.Len(t, DivFloat32s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]float32, size), input1, input2), MinOfFloat32s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]float32, size), input1, input2), MaxOfFloat32s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarFloat32s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampFloat32s(dst, input1, 2, 5), shortest(dst, input1), mode)
+			assert.Len(t, FMAFloat32s(dst, input1, input2, input1), size, mode)
+			assert.Len(t, AXPYFloat32s(dst, 2, input1, input2), size, mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Float32 ----------------------------------
 
-func BenchmarkInt64(b *testing.B) {
-	result := make([]Result, 0, 64)
+func TestFloat32_Compare(t *testing.T) {
+	rangeTiers(t, testFloat32Compare)
+}
 
-	typ := "int64"
-	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[int64](count)
-		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := int64(0)
-			for i := 0; i < b.N; i++ {
-				result = SumInt64s(vector)
+func testFloat32Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[float32](size)
+		words := (size + 63) / 64
+		for _, value := range []float32{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
 			}
-			assert.NotZero(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := int64(0)
-			for i := 0; i < b.N; i++ {
-				result = MinInt64s(vector)
+			assert.Equal(t, expect, EqualsFloat32s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsFloat32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanFloat32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanFloat32s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenFloat32s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsFloat32s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanFloat32s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanFloat32s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenFloat32s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenFloat32s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[float32](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
 			}
-			assert.NotZero(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := int64(0)
-			for i := 0; i < b.N; i++ {
-				result = MaxInt64s(vector)
+			assert.Equal(t, expect, CompareFloat32s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareFloat32s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareFloat32s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareFloat32s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareFloat32s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareFloat32s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Float32 ----------------------------------
+
+func TestFloat32_Masked(t *testing.T) {
+	rangeTiers(t, testFloat32Masked)
+}
+
+func testFloat32Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[float32](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanFloat32s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []float32
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
 			}
-			assert.NotZero(b, result)
-		}))
 
-		output := make([]int64, count)
-		input1 := makeVector[int64](count)
-		input2 := makeVector[int64](count)
-		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []int64
-			for i := 0; i < b.N; i++ {
-				result = AddInt64s(output, input1, input2)
+			assert.EqualValues(t, sum(selected), SumFloat32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumFloat32sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumFloat32sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinFloat32sMasked(input, mask)
+			hi, okHi := MaxFloat32sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
 			}
-			assert.NotEmpty(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []int64
-			for i := 0; i < b.N; i++ {
-				result = SubInt64s(output, input1, input2)
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]float32, capacity+1), make([]float32, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressFloat32s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]float32{}, selected[:count]...), append([]float32{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
 			}
-			assert.NotEmpty(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []int64
-			for i := 0; i < b.N; i++ {
-				result = MulInt64s(output, input1, input2)
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]float32, size), float32(7)
+			for i := range other {
+				other[i] = float32(i % 13)
 			}
-			assert.NotEmpty(b, result)
-		}))
 
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []int64
-			for i := 0; i < b.N; i++ {
-				result = DivInt64s(output, input1, input2)
+			expect, expectScalar := make([]float32, 0, size), make([]float32, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
 			}
-			assert.NotEmpty(b, result)
-		}))
-	}
 
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
+			assert.Equal(t, expect, SelectFloat32s(make([]float32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]float32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]float32, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectFloat32s(make([]float32, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarFloat32s(make([]float32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]float32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]float32, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectFloat32s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
 	}
 }
 
-// ---------------------------------- Test Int64 ----------------------------------
+// ---------------------------------- Test NaN Float32 ----------------------------------
 
-func TestInt64_Ops(t *testing.T) {
-	{ // Sum
-		input := makeVector[int64](70)
-		expect := sum(input)
-		result := SumInt64s(input)
-		assert.EqualValues(t, expect, result)
-	}
+func TestFloat32_NaN(t *testing.T) {
+	rangeTiers(t, testFloat32NaN)
+}
 
-	{ // Min
-		input := makeVector[int64](70)
-		expect := min(input)
-		result := MinInt64s(input)
-		assert.EqualValues(t, expect, result)
-	}
+func testFloat32NaN(t *testing.T) {
+	nan, inf, zero := float32(math.NaN()), float32(math.Inf(1)), float32(math.Copysign(0, -1))
+	same := func(expect, result float32, msg ...any) {
+		if math.IsNaN(float64(expect)) {
+			assert.True(t, math.IsNaN(float64(result)), msg...)
+			return
+		}
+		assert.Equal(t, expect, result, msg...)
+	}
+
+	for _, size := range []int{1, 7, 33, 70, 300, 1000} {
+		for _, at := range []int{0, size / 3, size - 1} {
+			{ // Infinities and negative zero are ordered as usual
+				input := makeVector[float32](size)
+				input[size-1-at] = zero
+				input[size/2], input[at] = inf, -inf
+				same(min(input), MinFloat32s(input), "size=%d at=%d", size, at)
+				same(max(input), MaxFloat32s(input), "size=%d at=%d", size, at)
+				same(nanmin(input), NanMinFloat32s(input), "size=%d at=%d", size, at)
+				same(nanmax(input), NanMaxFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmin(input), ArgMinFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmax(input), ArgMaxFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, -inf, MinFloat32s(input), "size=%d at=%d", size, at)
+			}
 
-	{ // Max
-		input := makeVector[int64](70)
-		expect := max(input)
-		result := MaxInt64s(input)
-		assert.EqualValues(t, expect, result)
-	}
+			for _, first := range []float32{zero, -zero} { // Mixed zeros are ordered the same as by math.Min and math.Max
+				input, mask := make([]float32, size), make([]uint64, (size+63)/64)
+				for i := range input {
+					input[i] = first
+				}
+				for i := range mask {
+					mask[i] = math.MaxUint64
+				}
+
+				input[at] = -first
+				lo, hi := float64(input[0]), float64(input[0])
+				for _, v := range input {
+					lo, hi = math.Min(lo, float64(v)), math.Max(hi, float64(v))
+				}
+
+				minMasked, _ := MinFloat32sMasked(input, mask)
+				maxMasked, _ := MaxFloat32sMasked(input, mask)
+				minmaxLo, minmaxHi := MinMaxFloat32s(input)
+				for i, v := range []float32{MinFloat32s(input), NanMinFloat32s(input), minmaxLo, minMasked, min(input), nanmin(input)} {
+					assert.Equal(t, math.Signbit(lo), math.Signbit(float64(v)), "size=%d at=%d first=%v op=%d", size, at, first, i)
+				}
+				for i, v := range []float32{MaxFloat32s(input), NanMaxFloat32s(input), minmaxHi, maxMasked, max(input), nanmax(input)} {
+					assert.Equal(t, math.Signbit(hi), math.Signbit(float64(v)), "size=%d at=%d first=%v op=%d", size, at, first, i)
+				}
+			}
 
-	{ // Add
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := add(make([]int64, 70), input1, input2)
-		result := AddInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			{ // NaN is propagated by Min/Max and ignored by NanMin/NanMax
+				input := makeVector[float32](size)
+				input[at] = nan
+				lo, hi := MinMaxFloat32s(input)
+				same(nan, MinFloat32s(input), "size=%d at=%d", size, at)
+				same(nan, MaxFloat32s(input), "size=%d at=%d", size, at)
+				same(nan, lo, "size=%d at=%d", size, at)
+				same(nan, hi, "size=%d at=%d", size, at)
+				same(min(input), MinFloat32s(input), "size=%d at=%d", size, at)
+				same(max(input), MaxFloat32s(input), "size=%d at=%d", size, at)
+				same(nanmin(input), NanMinFloat32s(input), "size=%d at=%d", size, at)
+				same(nanmax(input), NanMaxFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, at, ArgMinFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, at, ArgMaxFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmin(input), ArgMinFloat32s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmax(input), ArgMaxFloat32s(input), "size=%d at=%d", size, at)
+			}
 
-	{ // Sub
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := sub(make([]int64, 70), input1, input2)
-		result := SubInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			{ // NaN in either of the inputs is propagated by the element-wise MinOf/MaxOf
+				input1, input2 := makeVector[float32](size), makeVector[float32](size)
+				input1[size/3], input2[size/2] = zero, -inf
+				input1[at], input2[size-1-at] = nan, nan
+				for i, v := range [][]float32{
+					MinOfFloat32s(make([]float32, size), input1, input2),
+					MaxOfFloat32s(make([]float32, size), input1, input2),
+					MinOfFloat32s(make([]float32, size), input2, input1),
+					MaxOfFloat32s(make([]float32, size), input2, input1),
+					MinOfScalarFloat32s(make([]float32, size), input1, 50),
+					MaxOfScalarFloat32s(make([]float32, size), input1, 50),
+					MinOfScalarFloat32s(make([]float32, size), input1, nan),
+					MaxOfScalarFloat32s(make([]float32, size), input1, -inf),
+				} {
+					for j, expect := range [][]float32{
+						minOf(make([]float32, size), input1, input2),
+						maxOf(make([]float32, size), input1, input2),
+						minOf(make([]float32, size), input2, input1),
+						maxOf(make([]float32, size), input2, input1),
+						minOfScalar(make([]float32, size), input1, 50),
+						maxOfScalar(make([]float32, size), input1, 50),
+						minOfScalar(make([]float32, size), input1, nan),
+						maxOfScalar(make([]float32, size), input1, -inf),
+					}[i] {
+						same(expect, v[j], "size=%d at=%d op=%d i=%d", size, at, i, j)
+					}
+				}
+
+				same(nan, MinOfFloat32s(make([]float32, size), input1, input2)[at], "size=%d at=%d", size, at)
+				same(nan, MaxOfFloat32s(make([]float32, size), input2, input1)[at], "size=%d at=%d", size, at)
+				same(nan, MinOfFloat32s(make([]float32, size), input1, input2)[size-1-at], "size=%d at=%d", size, at)
+				same(nan, MaxOfFloat32s(make([]float32, size), input2, input1)[size-1-at], "size=%d at=%d", size, at)
+			}
 
-	{ // Mul
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := mul(make([]int64, 70), input1, input2)
-		result := MulInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+			{ // NaN is propagated by Clamp, either from the input or from the bounds
+				input := makeVector[float32](size)
+				input[size/3], input[size/2] = zero, inf
+				input[at] = nan
+				los, his := []float32{10, -inf, zero, 50, nan, 10}, []float32{50, inf, 0, 10, 50, nan}
+				for j, lo := range los {
+					hi := his[j]
+					result := ClampFloat32s(make([]float32, size), input, lo, hi)
+					for i, expect := range clamp(make([]float32, size), input, lo, hi) {
+						same(expect, result[i], "size=%d at=%d lo=%v hi=%v", size, at, lo, hi)
+					}
+					same(nan, result[at], "size=%d at=%d lo=%v hi=%v", size, at, lo, hi)
+				}
+			}
 
-	{ // Div
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := div(make([]int64, 70), input1, input2)
-		result := DivInt64s(make([]int64, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+			{ // NaN is neither equal to, less or greater than any value, nor within any range
+				input := makeVector[float32](size)
+				input[size/2] = inf
+				input[at] = nan
+				words := (size + 63) / 64
+				for _, value := range []float32{nan, 50, inf, -inf} {
+					assert.Equal(t, equals(input, value, make([]uint64, words)), EqualsFloat32s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, lessThan(input, value, make([]uint64, words)), LessThanFloat32s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, greaterThan(input, value, make([]uint64, words)), GreaterThanFloat32s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, between(input, -inf, value, make([]uint64, words)), BetweenFloat32s(input, -inf, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Zero(t, EqualsFloat32s(input, value, make([]uint64, words))[at/64]&(1<<(at%64)), "size=%d at=%d value=%v", size, at, value)
+					assert.Zero(t, BetweenFloat32s(input, -inf, inf, make([]uint64, words))[at/64]&(1<<(at%64)), "size=%d at=%d value=%v", size, at, value)
+				}
+
+				// NaN is only different from the other element, whichever side it is on
+				other := makeVector[float32](size)
+				other[size-1-at] = nan
+				for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+					assert.Equal(t, compare(input, other, op, make([]uint64, words)), CompareFloat32s(input, other, op, make([]uint64, words)), "size=%d at=%d op=%v", size, at, op)
+					assert.Equal(t, compare(other, input, op, make([]uint64, words)), CompareFloat32s(other, input, op, make([]uint64, words)), "size=%d at=%d op=%v", size, at, op)
+					assert.Equal(t, op == NotEqual, CompareFloat32s(input, other, op, make([]uint64, words))[at/64]&(1<<(at%64)) != 0, "size=%d at=%d op=%v", size, at, op)
+				}
+			}
+
+			{ // NaN is only taken into the masked reductions when it is selected
+				input := makeVector[float32](size)
+				input[at] = nan
+				mask := make([]uint64, (size+63)/64)
+				for i := range mask {
+					mask[i] = ^uint64(0)
+				}
+
+				mask[at/64] &^= 1 << (at % 64)
+				lo, _ := MinFloat32sMasked(input, mask)
+				hi, _ := MaxFloat32sMasked(input, mask)
+				expectLo, _ := minMasked(input, mask)
+				expectHi, _ := maxMasked(input, mask)
+				same(expectLo, lo, "size=%d at=%d", size, at)
+				same(expectHi, hi, "size=%d at=%d", size, at)
+				same(sumMasked(input, mask), SumFloat32sMasked(input, mask), "size=%d at=%d", size, at)
+
+				mask[at/64] |= 1 << (at % 64)
+				lo, _ = MinFloat32sMasked(input, mask)
+				hi, _ = MaxFloat32sMasked(input, mask)
+				same(nan, lo, "size=%d at=%d", size, at)
+				same(nan, hi, "size=%d at=%d", size, at)
+				same(nan, SumFloat32sMasked(input, mask), "size=%d at=%d", size, at)
+			}
+		}
+
+		{ // All NaN
+			input := make([]float32, size)
+			for i := range input {
+				input[i] = nan
+			}
+			same(nan, NanMinFloat32s(input), "size=%d", size)
+			same(nan, NanMaxFloat32s(input), "size=%d", size)
+			assert.Equal(t, 0, ArgMinFloat32s(input), "size=%d", size)
+			assert.Equal(t, 0, ArgMaxFloat32s(input), "size=%d", size)
+		}
 	}
 }
 
-// ---------------------------------- Test Fallback Int64 ----------------------------------
+// ---------------------------------- Test Sum Float32 ----------------------------------
 
-func TestInt64_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+func TestFloat32_SumOrder(t *testing.T) {
+	rangeTiers(t, testFloat32SumOrder)
+}
 
-	{ // Sum
-		input := makeVector[int64](70)
-		expect := sum(input)
-		result := SumInt64s(input)
-		assert.EqualValues(t, expect, result)
+func testFloat32SumOrder(t *testing.T) {
+	for _, size := range []int{0, 1, 7, lanesFloat32 - 1, lanesFloat32, lanesFloat32 + 1, 100, 255, 256, 257, 1000, 4096, 4099, 70000} {
+		input := make([]float32, size)
+		for i := range input {
+			input[i] = float32(math.Sin(float64(i)) * 1000)
+		}
+
+		// The result must be bit-for-bit the same as the generic one, on every tier
+		assert.Equal(t, sumKahan(input), SumFloat32sKahan(input), "size=%d", size)
+		assert.Equal(t, sumPairwise(input), SumFloat32sPairwise(input), "size=%d", size)
+		assert.Equal(t, SumFloat32sKahan(input), SumKahan(input), "size=%d", size)
+		assert.Equal(t, SumFloat32sPairwise(input), SumPairwise(input), "size=%d", size)
+	}
+
+	{ // Small values are not lost when added to a large one
+		tiny := float32(1e-8)
+		input := make([]float32, 10001)
+		input[0] = 1
+		for i := 1; i < len(input); i++ {
+			input[i] = tiny
+		}
+
+		expect := 1 + 10000*float64(tiny)
+		assert.InEpsilon(t, expect, float64(SumFloat32sKahan(input)), 1e-6)
+		assert.InEpsilon(t, expect, float64(SumFloat32sPairwise(input)), 1e-6)
+		assert.NotEqual(t, float32(1), SumFloat32sKahan(input))
 	}
+}
 
-	{ // Min
-		input := makeVector[int64](70)
-		expect := min(input)
-		result := MinInt64s(input)
-		assert.EqualValues(t, expect, result)
-	}
 
-	{ // Max
-		input := makeVector[int64](70)
-		expect := max(input)
-		result := MaxInt64s(input)
-		assert.EqualValues(t, expect, result)
-	}
+// ---------------------------------- Test Div Float32 ----------------------------------
 
-	{ // Add
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := add(make([]int64, 70), input1, input2)
-		result := AddInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+func TestFloat32_Div(t *testing.T) {
+	rangeTiers(t, testFloat32Div)
+}
 
-	{ // Sub
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := sub(make([]int64, 70), input1, input2)
-		result := SubInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+func testFloat32Div(t *testing.T) {
+	same := func(expect, result []float32, value float32) {
+		for i := range expect {
+			if math.Float64bits(float64(expect[i])) != math.Float64bits(float64(result[i])) && !(expect[i] != expect[i] && result[i] != result[i]) {
+				assert.Fail(t, "quotients differ", "value=%v at=%d expect=%v result=%v", value, i, expect[i], result[i])
+				return
+			}
+		}
 	}
 
-	{ // Mul
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := mul(make([]int64, 70), input1, input2)
-		result := MulInt64s(make([]int64, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+	// Pseudo-random bit patterns, which also cover infinities, NaNs and subnormals, along with small integers
+	input := []float32{0, 1, 3, 6, float32(math.Inf(1)), float32(math.Inf(-1)), float32(math.NaN()), float32(math.Copysign(0, -1))}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, math.Float32frombits(uint32(x)), float32(x>>59), float32(int64(x)>>53))
 	}
-
-	{ // Div
-		input1 := makeVector[int64](70)
-		input2 := makeVector[int64](70)
-		expect := div(make([]int64, 70), input1, input2)
-		result := DivInt64s(make([]int64, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+
+	// Quotients are exact on every instruction set, the same as in Go
+	for _, value := range input {
+		expect := make([]float32, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		same(expect, DivScalarFloat32s(make([]float32, len(input)), input, value), value)
+
+		divisors := make([]float32, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		same(expect, DivFloat32s(make([]float32, len(input)), input, divisors), value)
+		same(expect, Div(make([]float32, len(input)), input, divisors), value)
+
+		for i, v := range input {
+			expect[i] = value / v
+		}
+		same(expect, RevDivScalarFloat32s(make([]float32, len(input)), input, value), value)
 	}
 }
 
-// ---------------------------------- Benchmark Float32 ----------------------------------
 
-func BenchmarkFloat32(b *testing.B) {
+// ---------------------------------- Benchmark Float64 ----------------------------------
+
+func BenchmarkFloat64(b *testing.B) {
 	result := make([]Result, 0, 64)
 
-	typ := "float32"
+	typ := "float64"
 	for _, count := range []int{256, 4096, 16384} {
-		vector := makeVector[float32](count)
+		vector := makeVector[float64](count)
 		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
-			result := float32(0)
+			result := float64(0)
 			for i := 0; i < b.N; i++ {
-				result = SumFloat32s(vector)
+				result = SumFloat64s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
-			result := float32(0)
+			result := float64(0)
 			for i := 0; i < b.N; i++ {
-				result = MinFloat32s(vector)
+				result = MinFloat64s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
-			result := float32(0)
+			result := float64(0)
 			for i := 0; i < b.N; i++ {
-				result = MaxFloat32s(vector)
+				result = MaxFloat64s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		output := make([]float32, count)
-		input1 := makeVector[float32](count)
-		input2 := makeVector[float32](count)
+		result = append(result, runBenchmark(b, typ, "minmax", count, func(b *testing.B) {
+			lo, hi := float64(0), float64(0)
+			for i := 0; i < b.N; i++ {
+				lo, hi = MinMaxFloat64s(vector)
+			}
+			assert.NotZero(b, lo)
+			assert.NotZero(b, hi)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amin", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMinFloat64s(vector)
+			}
+			assert.Equal(b, 0, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "amax", count, func(b *testing.B) {
+			result := -1
+			for i := 0; i < b.N; i++ {
+				result = ArgMaxFloat64s(vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
+			result := float64(0)
+			for i := 0; i < b.N; i++ {
+				result = DotFloat64s(vector, vector)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		output := make([]float64, count)
+		input1 := makeVector[float64](count)
+		input2 := makeVector[float64](count)
 		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
-			var result []float32
+			var result []float64
 			for i := 0; i < b.N; i++ {
-				result = AddFloat32s(output, input1, input2)
+				result = AddFloat64s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []float32
+			var result []float64
 			for i := 0; i < b.N; i++ {
-				result = SubFloat32s(output, input1, input2)
+				result = SubFloat64s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []float32
+			var result []float64
 			for i := 0; i < b.N; i++ {
-				result = MulFloat32s(output, input1, input2)
+				result = MulFloat64s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
 
 		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []float32
+			var result []float64
 			for i := 0; i < b.N; i++ {
-				result = DivFloat32s(output, input1, input2)
+				result = DivFloat64s(output, input1, input2)
 			}
 			assert.NotEmpty(b, result)
 		}))
-	}
 
-	// Print out the result and respective speed-up
-	fmt.Println()
-	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
-	for _, r := range result {
-		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
-			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
-		)
-	}
-}
+		result = append(result, runBenchmark(b, typ, "minof", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = MinOfFloat64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-// ---------------------------------- Test Float32 ----------------------------------
+		result = append(result, runBenchmark(b, typ, "maxof", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = MaxOfFloat64s(output, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-func TestFloat32_Ops(t *testing.T) {
-	{ // Sum
-		input := makeVector[float32](70)
-		expect := sum(input)
-		result := SumFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "clamp", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = ClampFloat64s(output, input1, 20, 60)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Min
-		input := makeVector[float32](70)
-		expect := min(input)
-		result := MinFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "eq", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = EqualsFloat64s(input1, 50, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Max
-		input := makeVector[float32](70)
-		expect := max(input)
-		result := MaxFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "btw", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = BetweenFloat64s(input1, 20, 60, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Add
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := add(make([]float32, 70), input1, input2)
-		result := AddFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "cmp", count, func(b *testing.B) {
+			bitmap := make([]uint64, count/64)
+			for i := 0; i < b.N; i++ {
+				bitmap = CompareFloat64s(input1, input2, Less, bitmap)
+			}
+			assert.NotEmpty(b, bitmap)
+		}))
 
-	{ // Sub
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := sub(make([]float32, 70), input1, input2)
-		result := SubFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "msum", count, func(b *testing.B) {
+			mask := LessThanFloat64s(input1, 30, make([]uint64, count/64))
+			var result float64
+			for i := 0; i < b.N; i++ {
+				result = SumFloat64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-	{ // Mul
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := mul(make([]float32, 70), input1, input2)
-		result := MulFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "mmin", count, func(b *testing.B) {
+			mask := LessThanFloat64s(input1, 30, make([]uint64, count/64))
+			var result float64
+			for i := 0; i < b.N; i++ {
+				result, _ = MinFloat64sMasked(input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-	{ // Div
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := div(make([]float32, 70), input1, input2)
-		result := DivFloat32s(make([]float32, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
-	}
-}
+		result = append(result, runBenchmark(b, typ, "cpr", count, func(b *testing.B) {
+			mask := LessThanFloat64s(input1, 30, make([]uint64, count/64))
+			var result int
+			for i := 0; i < b.N; i++ {
+				result = CompressFloat64s(output, input1, mask)
+			}
+			assert.NotZero(b, result)
+		}))
 
-// ---------------------------------- Test Fallback Float32 ----------------------------------
+		result = append(result, runBenchmark(b, typ, "sel", count, func(b *testing.B) {
+			mask := LessThanFloat64s(input1, 30, make([]uint64, count/64))
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = SelectFloat64s(output, input1, input2, mask)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-func TestFloat32_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+		result = append(result, runBenchmark(b, typ, "adds", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = AddScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Sum
-		input := makeVector[float32](70)
-		expect := sum(input)
-		result := SumFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "subs", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = SubScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Min
-		input := makeVector[float32](70)
-		expect := min(input)
-		result := MinFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "rsubs", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = RevSubScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Max
-		input := makeVector[float32](70)
-		expect := max(input)
-		result := MaxFloat32s(input)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "muls", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = MulScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Add
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := add(make([]float32, 70), input1, input2)
-		result := AddFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "divs", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = DivScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Sub
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := sub(make([]float32, 70), input1, input2)
-		result := SubFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
-	}
+		result = append(result, runBenchmark(b, typ, "rdivs", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = RevDivScalarFloat64s(output, input1, 2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 
-	{ // Mul
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := mul(make([]float32, 70), input1, input2)
-		result := MulFloat32s(make([]float32, 70), input1, input2)
-		assert.EqualValues(t, expect, result)
+		result = append(result, runBenchmark(b, typ, "ksum", count, func(b *testing.B) {
+			var result float64
+			for i := 0; i < b.N; i++ {
+				result = SumFloat64sKahan(input1)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "psum", count, func(b *testing.B) {
+			var result float64
+			for i := 0; i < b.N; i++ {
+				result = SumFloat64sPairwise(input1)
+			}
+			assert.NotZero(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "fma", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = FMAFloat64s(output, input1, input2, input1)
+			}
+			assert.NotEmpty(b, result)
+		}))
+
+		result = append(result, runBenchmark(b, typ, "axpy", count, func(b *testing.B) {
+			var result []float64
+			for i := 0; i < b.N; i++ {
+				result = AXPYFloat64s(output, 2, input1, input2)
+			}
+			assert.NotEmpty(b, result)
+		}))
 	}
 
-	{ // Div
-		input1 := makeVector[float32](70)
-		input2 := makeVector[float32](70)
-		expect := div(make([]float32, 70), input1, input2)
-		result := DivFloat32s(make([]float32, 70), input1, input2)
-		assert.InDeltaSlice(t, expect, result, 0.01)
+	// Print out the result and respective speed-up
+	fmt.Println()
+	fmt.Println("   TYPE    OP    SIZE     RATE        SPEEDUP")
+	for _, r := range result {
+		fmt.Printf("%7s %5s %7d %8.2f ns/op %7.2fx\n",
+			r.Type, r.Name, r.Size, r.Rate, r.Speedup,
+		)
 	}
 }
 
-// ---------------------------------- Benchmark Float64 ----------------------------------
-
-func BenchmarkFloat64(b *testing.B) {
+func BenchmarkFloat64_Small(b *testing.B) {
 	result := make([]Result, 0, 64)
 
 	typ := "float64"
-	for _, count := range []int{256, 4096, 16384} {
+	for _, count := range []int{1, 2, 4, 8, 16, 32, 64} {
 		vector := makeVector[float64](count)
+		output := make([]float64, count)
 		result = append(result, runBenchmark(b, typ, "sum", count, func(b *testing.B) {
 			result := float64(0)
 			for i := 0; i < b.N; i++ {
@@ -1800,53 +9072,26 @@ func BenchmarkFloat64(b *testing.B) {
 			assert.NotZero(b, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "min", count, func(b *testing.B) {
+		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
 			result := float64(0)
 			for i := 0; i < b.N; i++ {
-				result = MinFloat64s(vector)
+				result = MaxFloat64s(vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		result = append(result, runBenchmark(b, typ, "max", count, func(b *testing.B) {
+		result = append(result, runBenchmark(b, typ, "dot", count, func(b *testing.B) {
 			result := float64(0)
 			for i := 0; i < b.N; i++ {
-				result = MaxFloat64s(vector)
+				result = DotFloat64s(vector, vector)
 			}
 			assert.NotZero(b, result)
 		}))
 
-		output := make([]float64, count)
-		input1 := makeVector[float64](count)
-		input2 := makeVector[float64](count)
 		result = append(result, runBenchmark(b, typ, "add", count, func(b *testing.B) {
 			var result []float64
 			for i := 0; i < b.N; i++ {
-				result = AddFloat64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "sub", count, func(b *testing.B) {
-			var result []float64
-			for i := 0; i < b.N; i++ {
-				result = SubFloat64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "mul", count, func(b *testing.B) {
-			var result []float64
-			for i := 0; i < b.N; i++ {
-				result = MulFloat64s(output, input1, input2)
-			}
-			assert.NotEmpty(b, result)
-		}))
-
-		result = append(result, runBenchmark(b, typ, "div", count, func(b *testing.B) {
-			var result []float64
-			for i := 0; i < b.N; i++ {
-				result = DivFloat64s(output, input1, input2)
+				result = AddFloat64s(output, vector, vector)
 			}
 			assert.NotEmpty(b, result)
 		}))
@@ -1865,6 +9110,10 @@ func BenchmarkFloat64(b *testing.B) {
 // ---------------------------------- Test Float64 ----------------------------------
 
 func TestFloat64_Ops(t *testing.T) {
+	rangeTiers(t, testFloat64Ops)
+}
+
+func testFloat64Ops(t *testing.T) {
 	{ // Sum
 		input := makeVector[float64](70)
 		expect := sum(input)
@@ -1886,6 +9135,38 @@ func TestFloat64_Ops(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // MinMax
+		input := makeVector[float64](70)
+		lo, hi := MinMaxFloat64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[float64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinFloat64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[float64](1000)
+		expect := argmax(input)
+		result := ArgMaxFloat64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		expect := dot(input1, input2)
+		result := DotFloat64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Add
 		input1 := makeVector[float64](70)
 		input2 := makeVector[float64](70)
@@ -1917,15 +9198,111 @@ func TestFloat64_Ops(t *testing.T) {
 		result := DivFloat64s(make([]float64, 70), input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
+
+	{ // AddScalar
+		input := makeVector[float64](70)
+		expect := addScalar(make([]float64, 70), input, 2)
+		result := AddScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[float64](70)
+		expect := subScalar(make([]float64, 70), input, 2)
+		result := SubScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[float64](70)
+		expect := rsubScalar(make([]float64, 70), input, 2)
+		result := RevSubScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[float64](70)
+		expect := mulScalar(make([]float64, 70), input, 2)
+		result := MulScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[float64](70)
+		expect := divScalar(make([]float64, 70), input, 2)
+		result := DivScalarFloat64s(make([]float64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[float64](70)
+		expect := rdivScalar(make([]float64, 70), input, 2)
+		result := RevDivScalarFloat64s(make([]float64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](90)[20:]
+		expect := minOf(make([]float64, 70), input1, input2)
+		result := MinOfFloat64s(make([]float64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](90)[20:]
+		expect := maxOf(make([]float64, 70), input1, input2)
+		result := MaxOfFloat64s(make([]float64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[float64](70)
+		expect := minOfScalar(make([]float64, 70), input, 50)
+		result := MinOfScalarFloat64s(make([]float64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[float64](70)
+		expect := maxOfScalar(make([]float64, 70), input, 50)
+		result := MaxOfScalarFloat64s(make([]float64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[float64](70)
+		expect := clamp(make([]float64, 70), input, 20, 60)
+		result := ClampFloat64s(make([]float64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]float64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]float64, 70), input, 60, 20), ClampFloat64s(make([]float64, 70), input, 60, 20))
+	}
+
+	{ // FMA
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		input3 := makeVector[float64](70)
+		expect := fmadd(make([]float64, 70), input1, input2, input3)
+		result := FMAFloat64s(make([]float64, 70), input1, input2, input3)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AXPY
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		expect := axpy(make([]float64, 70), 2, input1, input2)
+		result := AXPYFloat64s(make([]float64, 70), 2, input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
 }
 
 // ---------------------------------- Test Fallback Float64 ----------------------------------
 
 func TestFloat64_Fallback_Sum(t *testing.T) {
-	defer func(v bool){
-		avx2 = v
-	}(avx2)
-	avx2 = false
+	defer SetLevel(Features())
+	SetLevel(Generic)
 
 	{ // Sum
 		input := makeVector[float64](70)
@@ -1948,6 +9325,38 @@ func TestFloat64_Fallback_Sum(t *testing.T) {
 		assert.EqualValues(t, expect, result)
 	}
 
+	{ // MinMax
+		input := makeVector[float64](70)
+		lo, hi := MinMaxFloat64s(input)
+		assert.EqualValues(t, min(input), lo)
+		assert.EqualValues(t, max(input), hi)
+	}
+
+	{ // ArgMin
+		input := makeVector[float64](1000)
+		input[500] = 0
+		expect := argmin(input)
+		result := ArgMinFloat64s(input)
+		assert.Equal(t, 500, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // ArgMax
+		input := makeVector[float64](1000)
+		expect := argmax(input)
+		result := ArgMaxFloat64s(input)
+		assert.Equal(t, 99, result)
+		assert.Equal(t, expect, result)
+	}
+
+	{ // Dot
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		expect := dot(input1, input2)
+		result := DotFloat64s(input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
 	{ // Add
 		input1 := makeVector[float64](70)
 		input2 := makeVector[float64](70)
@@ -1979,5 +9388,600 @@ func TestFloat64_Fallback_Sum(t *testing.T) {
 		result := DivFloat64s(make([]float64, 70), input1, input2)
 		assert.InDeltaSlice(t, expect, result, 0.01)
 	}
+
+	{ // AddScalar
+		input := makeVector[float64](70)
+		expect := addScalar(make([]float64, 70), input, 2)
+		result := AddScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // SubScalar
+		input := makeVector[float64](70)
+		expect := subScalar(make([]float64, 70), input, 2)
+		result := SubScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // RevSubScalar
+		input := makeVector[float64](70)
+		expect := rsubScalar(make([]float64, 70), input, 2)
+		result := RevSubScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MulScalar
+		input := makeVector[float64](70)
+		expect := mulScalar(make([]float64, 70), input, 2)
+		result := MulScalarFloat64s(make([]float64, 70), input, 2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // DivScalar
+		input := makeVector[float64](70)
+		expect := divScalar(make([]float64, 70), input, 2)
+		result := DivScalarFloat64s(make([]float64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // RevDivScalar
+		input := makeVector[float64](70)
+		expect := rdivScalar(make([]float64, 70), input, 2)
+		result := RevDivScalarFloat64s(make([]float64, 70), input, 2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // MinOf
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](90)[20:]
+		expect := minOf(make([]float64, 70), input1, input2)
+		result := MinOfFloat64s(make([]float64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOf
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](90)[20:]
+		expect := maxOf(make([]float64, 70), input1, input2)
+		result := MaxOfFloat64s(make([]float64, 70), input1, input2)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MinOfScalar
+		input := makeVector[float64](70)
+		expect := minOfScalar(make([]float64, 70), input, 50)
+		result := MinOfScalarFloat64s(make([]float64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // MaxOfScalar
+		input := makeVector[float64](70)
+		expect := maxOfScalar(make([]float64, 70), input, 50)
+		result := MaxOfScalarFloat64s(make([]float64, 70), input, 50)
+		assert.EqualValues(t, expect, result)
+	}
+
+	{ // Clamp
+		input := makeVector[float64](70)
+		expect := clamp(make([]float64, 70), input, 20, 60)
+		result := ClampFloat64s(make([]float64, 70), input, 20, 60)
+		assert.EqualValues(t, expect, result)
+		assert.EqualValues(t, expect, Clamp(make([]float64, 70), input, 20, 60))
+		assert.EqualValues(t, clamp(make([]float64, 70), input, 60, 20), ClampFloat64s(make([]float64, 70), input, 60, 20))
+	}
+
+	{ // FMA
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		input3 := makeVector[float64](70)
+		expect := fmadd(make([]float64, 70), input1, input2, input3)
+		result := FMAFloat64s(make([]float64, 70), input1, input2, input3)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+
+	{ // AXPY
+		input1 := makeVector[float64](70)
+		input2 := makeVector[float64](70)
+		expect := axpy(make([]float64, 70), 2, input1, input2)
+		result := AXPYFloat64s(make([]float64, 70), 2, input1, input2)
+		assert.InDeltaSlice(t, expect, result, 0.01)
+	}
+}
+
+// ---------------------------------- Test Bounds Float64 ----------------------------------
+
+func TestFloat64_Bounds(t *testing.T) {
+	rangeModes(func(mode string) {
+		empty := []float64{}
+		assert.Zero(t, SumFloat64s(nil), mode)
+		assert.Zero(t, SumFloat64s(empty), mode)
+		assert.Zero(t, DotFloat64s(empty, makeVector[float64](70)), mode)
+		assert.Panics(t, func() { MinFloat64s(empty) }, mode)
+		assert.Panics(t, func() { MaxFloat64s(empty) }, mode)
+		assert.Panics(t, func() { MinMaxFloat64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMinFloat64s(empty) }, mode)
+		assert.Panics(t, func() { ArgMaxFloat64s(empty) }, mode)
+		assert.Panics(t, func() { NanMinFloat64s(empty) }, mode)
+		assert.Panics(t, func() { NanMaxFloat64s(empty) }, mode)
+
+		// Dot product only takes the common part of both inputs
+		long, short := makeVector[float64](70), makeVector[float64](33)
+		assert.EqualValues(t, dot(short, short), DotFloat64s(long, short), mode)
+		assert.EqualValues(t, dot(short, short), DotFloat64s(short, long), mode)
+
+		// Element-wise operations are bounded by the shortest slice
+		for _, n := range [][3]int{
+			{70, 33, 70},
+			{70, 70, 33},
+			{33, 70, 70},
+			{0, 70, 70},
+			{70, 0, 70},
+		} {
+			dst, input1, input2 := make([]float64, n[0]), makeVector[float64](n[1]), makeVector[float64](n[2])
+			size := shortest(dst, input1, input2)
+			assert.EqualValues(t, add(make([]float64, size), input1, input2), AddFloat64s(dst, input1, input2), mode)
+			assert.EqualValues(t, sub(make([]float64, size), input1, input2), SubFloat64s(dst, input1, input2), mode)
+			assert.EqualValues(t, mul(make([]float64, size), input1, input2), MulFloat64s(dst, input1, input2), mode)
+			assert.Len(t, DivFloat64s(dst, input1, input2), size, mode)
+			assert.Len(t, AddScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, SubScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevSubScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MulScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, DivScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, RevDivScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.EqualValues(t, minOf(make([]float64, size), input1, input2), MinOfFloat64s(dst, input1, input2), mode)
+			assert.EqualValues(t, maxOf(make([]float64, size), input1, input2), MaxOfFloat64s(dst, input1, input2), mode)
+			assert.Len(t, MinOfScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, MaxOfScalarFloat64s(dst, input1, 2), shortest(dst, input1), mode)
+			assert.Len(t, ClampFloat64s(dst, input1, 2, 5), shortest(dst, input1), mode)
+			assert.Len(t, FMAFloat64s(dst, input1, input2, input1), size, mode)
+			assert.Len(t, AXPYFloat64s(dst, 2, input1, input2), size, mode)
+		}
+	})
+}
+// ---------------------------------- Test Compare Float64 ----------------------------------
+
+func TestFloat64_Compare(t *testing.T) {
+	rangeTiers(t, testFloat64Compare)
+}
+
+func testFloat64Compare(t *testing.T) {
+	filled := func(n int) []uint64 {
+		bitmap := make([]uint64, n)
+		for i := range bitmap {
+			bitmap[i] = ^uint64(0)
+		}
+		return bitmap
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[float64](size)
+		words := (size + 63) / 64
+		for _, value := range []float64{0, 1, 50, 100, 101} {
+			expect := make([]uint64, words)
+			for i, v := range input {
+				if v == value {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, EqualsFloat64s(input, value, filled(words+1)), "size=%d value=%v", size, value)
+			assert.Equal(t, equals(input, value, filled(words)), EqualsFloat64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, lessThan(input, value, filled(words)), LessThanFloat64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, greaterThan(input, value, filled(words)), GreaterThanFloat64s(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, between(input, 10, value, filled(words)), BetweenFloat64s(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, EqualsFloat64s(input, value, filled(words)), Equals(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, LessThanFloat64s(input, value, filled(words)), LessThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, GreaterThanFloat64s(input, value, filled(words)), GreaterThan(input, value, filled(words)), "size=%d value=%v", size, value)
+			assert.Equal(t, BetweenFloat64s(input, 10, value, filled(words)), Between(input, 10, value, filled(words)), "size=%d value=%v", size, value)
+		}
+
+		// Only as many elements as fit into the bitmap are compared
+		if words > 1 {
+			assert.Equal(t, between(input[:64], 10, 50, filled(1)), BetweenFloat64s(input, 10, 50, filled(1)), "size=%d", size)
+		}
+
+		// Pairs of elements are compared against each other
+		other := makeVector[float64](size + 37)[37:]
+		for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+			expect := make([]uint64, words)
+			for i := range input {
+				if matches(input[i], other[i], op) {
+					expect[i/64] |= 1 << (i % 64)
+				}
+			}
+
+			assert.Equal(t, expect, CompareFloat64s(input, other, op, filled(words+1)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, other, op, filled(words)), CompareFloat64s(input, other, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input, input, op, filled(words)), CompareFloat64s(input, input, op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, compare(input[:size/2], other, op, filled(words)), CompareFloat64s(input, other[:size/2], op, filled(words)), "size=%d op=%v", size, op)
+			assert.Equal(t, CompareFloat64s(input, other, op, filled(words)), Compare(input, other, op, filled(words)), "size=%d op=%v", size, op)
+		}
+
+		if size > 0 {
+			assert.Panics(t, func() { CompareFloat64s(input, other, GreaterEqual+1, filled(words)) }, "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Masked Float64 ----------------------------------
+
+func TestFloat64_Masked(t *testing.T) {
+	rangeTiers(t, testFloat64Masked)
+}
+
+func testFloat64Masked(t *testing.T) {
+	pattern := func(n int, word uint64) []uint64 {
+		mask := make([]uint64, n)
+		for i := range mask {
+			mask[i] = word
+		}
+		return mask
+	}
+
+	for _, size := range []int{0, 1, 63, 64, 65, 100, 128, 1000} {
+		input := makeVector[float64](size)
+		words := (size + 63) / 64
+		last := make([]uint64, words)
+		if size > 0 {
+			last[(size-1)/64] = 1 << ((size - 1) % 64)
+		}
+
+		for _, mask := range [][]uint64{
+			pattern(words+1, 0),
+			pattern(words+1, ^uint64(0)),
+			pattern(words, 0x5555555555555555),
+			pattern(1, ^uint64(0)),
+			LessThanFloat64s(input, 30, make([]uint64, words)),
+			last,
+		} {
+			var selected []float64
+			for i, v := range input {
+				if i < len(mask)*64 && mask[i/64]&(1<<(i%64)) != 0 {
+					selected = append(selected, v)
+				}
+			}
+
+			assert.EqualValues(t, sum(selected), SumFloat64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, sumMasked(input, mask), SumFloat64sMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.EqualValues(t, SumFloat64sMasked(input, mask), SumMasked(input, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected), CountMasked(len(input), mask), "size=%d mask=%x", size, mask)
+
+			lo, okLo := MinFloat64sMasked(input, mask)
+			hi, okHi := MaxFloat64sMasked(input, mask)
+			assert.Equal(t, len(selected) > 0, okLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, len(selected) > 0, okHi, "size=%d mask=%x", size, mask)
+			if len(selected) > 0 {
+				assert.Equal(t, min(selected), lo, "size=%d mask=%x", size, mask)
+				assert.Equal(t, max(selected), hi, "size=%d mask=%x", size, mask)
+			}
+
+			expectLo, expectOk := minMasked(input, mask)
+			resultLo, resultOk := MinMasked(input, mask)
+			assert.Equal(t, expectLo, resultLo, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+			expectHi, expectOk := maxMasked(input, mask)
+			resultHi, resultOk := MaxMasked(input, mask)
+			assert.Equal(t, expectHi, resultHi, "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectOk, resultOk, "size=%d mask=%x", size, mask)
+
+			// Selected elements are copied next to each other, as many as fit, and the rest of dst is left as is
+			for _, capacity := range []int{0, len(selected) / 2, len(selected), size + 3} {
+				count := len(selected)
+				if capacity < count {
+					count = capacity
+				}
+
+				dst, other := make([]float64, capacity+1), make([]float64, capacity)
+				for i := range dst {
+					dst[i] = 127
+				}
+
+				n := CompressFloat64s(dst[:capacity], input, mask)
+				assert.Equal(t, count, n, "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, append([]float64{}, selected[:count]...), append([]float64{}, dst[:n]...), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				for i := n; i < len(dst); i++ {
+					assert.EqualValues(t, 127, dst[i], "size=%d mask=%x capacity=%d at=%d", size, mask, capacity, i)
+				}
+
+				assert.Equal(t, n, compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, n, Compress(other, input, mask), "size=%d mask=%x capacity=%d", size, mask, capacity)
+				assert.Equal(t, dst[:n], other[:n], "size=%d mask=%x capacity=%d", size, mask, capacity)
+			}
+
+			// Selected elements come from the input and the others from the second input, up to the end of the mask
+			other, value := make([]float64, size), float64(7)
+			for i := range other {
+				other[i] = float64(i % 13)
+			}
+
+			expect, expectScalar := make([]float64, 0, size), make([]float64, 0, size)
+			for i := 0; i < size && i < len(mask)*64; i++ {
+				if mask[i/64]&(1<<(i%64)) != 0 {
+					expect, expectScalar = append(expect, input[i]), append(expectScalar, input[i])
+				} else {
+					expect, expectScalar = append(expect, other[i]), append(expectScalar, value)
+				}
+			}
+
+			assert.Equal(t, expect, SelectFloat64s(make([]float64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, blend(make([]float64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, Select(make([]float64, size), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect[:len(expect)/2], SelectFloat64s(make([]float64, len(expect)/2), input, other, mask), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalarFloat64s(make([]float64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, blendScalar(make([]float64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expectScalar, SelectScalar(make([]float64, size), input, mask, value), "size=%d mask=%x", size, mask)
+			assert.Equal(t, expect, SelectFloat64s(other, input, other, mask), "size=%d mask=%x", size, mask)
+		}
+	}
+}
+
+// ---------------------------------- Test NaN Float64 ----------------------------------
+
+func TestFloat64_NaN(t *testing.T) {
+	rangeTiers(t, testFloat64NaN)
+}
+
+func testFloat64NaN(t *testing.T) {
+	nan, inf, zero := float64(math.NaN()), float64(math.Inf(1)), float64(math.Copysign(0, -1))
+	same := func(expect, result float64, msg ...any) {
+		if math.IsNaN(float64(expect)) {
+			assert.True(t, math.IsNaN(float64(result)), msg...)
+			return
+		}
+		assert.Equal(t, expect, result, msg...)
+	}
+
+	for _, size := range []int{1, 7, 33, 70, 300, 1000} {
+		for _, at := range []int{0, size / 3, size - 1} {
+			{ // Infinities and negative zero are ordered as usual
+				input := makeVector[float64](size)
+				input[size-1-at] = zero
+				input[size/2], input[at] = inf, -inf
+				same(min(input), MinFloat64s(input), "size=%d at=%d", size, at)
+				same(max(input), MaxFloat64s(input), "size=%d at=%d", size, at)
+				same(nanmin(input), NanMinFloat64s(input), "size=%d at=%d", size, at)
+				same(nanmax(input), NanMaxFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmin(input), ArgMinFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmax(input), ArgMaxFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, -inf, MinFloat64s(input), "size=%d at=%d", size, at)
+			}
+
+			for _, first := range []float64{zero, -zero} { // Mixed zeros are ordered the same as by math.Min and math.Max
+				input, mask := make([]float64, size), make([]uint64, (size+63)/64)
+				for i := range input {
+					input[i] = first
+				}
+				for i := range mask {
+					mask[i] = math.MaxUint64
+				}
+
+				input[at] = -first
+				lo, hi := float64(input[0]), float64(input[0])
+				for _, v := range input {
+					lo, hi = math.Min(lo, float64(v)), math.Max(hi, float64(v))
+				}
+
+				minMasked, _ := MinFloat64sMasked(input, mask)
+				maxMasked, _ := MaxFloat64sMasked(input, mask)
+				minmaxLo, minmaxHi := MinMaxFloat64s(input)
+				for i, v := range []float64{MinFloat64s(input), NanMinFloat64s(input), minmaxLo, minMasked, min(input), nanmin(input)} {
+					assert.Equal(t, math.Signbit(lo), math.Signbit(float64(v)), "size=%d at=%d first=%v op=%d", size, at, first, i)
+				}
+				for i, v := range []float64{MaxFloat64s(input), NanMaxFloat64s(input), minmaxHi, maxMasked, max(input), nanmax(input)} {
+					assert.Equal(t, math.Signbit(hi), math.Signbit(float64(v)), "size=%d at=%d first=%v op=%d", size, at, first, i)
+				}
+			}
+
+			{ // NaN is propagated by Min/Max and ignored by NanMin/NanMax
+				input := makeVector[float64](size)
+				input[at] = nan
+				lo, hi := MinMaxFloat64s(input)
+				same(nan, MinFloat64s(input), "size=%d at=%d", size, at)
+				same(nan, MaxFloat64s(input), "size=%d at=%d", size, at)
+				same(nan, lo, "size=%d at=%d", size, at)
+				same(nan, hi, "size=%d at=%d", size, at)
+				same(min(input), MinFloat64s(input), "size=%d at=%d", size, at)
+				same(max(input), MaxFloat64s(input), "size=%d at=%d", size, at)
+				same(nanmin(input), NanMinFloat64s(input), "size=%d at=%d", size, at)
+				same(nanmax(input), NanMaxFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, at, ArgMinFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, at, ArgMaxFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmin(input), ArgMinFloat64s(input), "size=%d at=%d", size, at)
+				assert.Equal(t, argmax(input), ArgMaxFloat64s(input), "size=%d at=%d", size, at)
+			}
+
+			{ // NaN in either of the inputs is propagated by the element-wise MinOf/MaxOf
+				input1, input2 := makeVector[float64](size), makeVector[float64](size)
+				input1[size/3], input2[size/2] = zero, -inf
+				input1[at], input2[size-1-at] = nan, nan
+				for i, v := range [][]float64{
+					MinOfFloat64s(make([]float64, size), input1, input2),
+					MaxOfFloat64s(make([]float64, size), input1, input2),
+					MinOfFloat64s(make([]float64, size), input2, input1),
+					MaxOfFloat64s(make([]float64, size), input2, input1),
+					MinOfScalarFloat64s(make([]float64, size), input1, 50),
+					MaxOfScalarFloat64s(make([]float64, size), input1, 50),
+					MinOfScalarFloat64s(make([]float64, size), input1, nan),
+					MaxOfScalarFloat64s(make([]float64, size), input1, -inf),
+				} {
+					for j, expect := range [][]float64{
+						minOf(make([]float64, size), input1, input2),
+						maxOf(make([]float64, size), input1, input2),
+						minOf(make([]float64, size), input2, input1),
+						maxOf(make([]float64, size), input2, input1),
+						minOfScalar(make([]float64, size), input1, 50),
+						maxOfScalar(make([]float64, size), input1, 50),
+						minOfScalar(make([]float64, size), input1, nan),
+						maxOfScalar(make([]float64, size), input1, -inf),
+					}[i] {
+						same(expect, v[j], "size=%d at=%d op=%d i=%d", size, at, i, j)
+					}
+				}
+
+				same(nan, MinOfFloat64s(make([]float64, size), input1, input2)[at], "size=%d at=%d", size, at)
+				same(nan, MaxOfFloat64s(make([]float64, size), input2, input1)[at], "size=%d at=%d", size, at)
+				same(nan, MinOfFloat64s(make([]float64, size), input1, input2)[size-1-at], "size=%d at=%d", size, at)
+				same(nan, MaxOfFloat64s(make([]float64, size), input2, input1)[size-1-at], "size=%d at=%d", size, at)
+			}
+
+			{ // NaN is propagated by Clamp, either from the input or from the bounds
+				input := makeVector[float64](size)
+				input[size/3], input[size/2] = zero, inf
+				input[at] = nan
+				los, his := []float64{10, -inf, zero, 50, nan, 10}, []float64{50, inf, 0, 10, 50, nan}
+				for j, lo := range los {
+					hi := his[j]
+					result := ClampFloat64s(make([]float64, size), input, lo, hi)
+					for i, expect := range clamp(make([]float64, size), input, lo, hi) {
+						same(expect, result[i], "size=%d at=%d lo=%v hi=%v", size, at, lo, hi)
+					}
+					same(nan, result[at], "size=%d at=%d lo=%v hi=%v", size, at, lo, hi)
+				}
+			}
+
+			{ // NaN is neither equal to, less or greater than any value, nor within any range
+				input := makeVector[float64](size)
+				input[size/2] = inf
+				input[at] = nan
+				words := (size + 63) / 64
+				for _, value := range []float64{nan, 50, inf, -inf} {
+					assert.Equal(t, equals(input, value, make([]uint64, words)), EqualsFloat64s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, lessThan(input, value, make([]uint64, words)), LessThanFloat64s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, greaterThan(input, value, make([]uint64, words)), GreaterThanFloat64s(input, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Equal(t, between(input, -inf, value, make([]uint64, words)), BetweenFloat64s(input, -inf, value, make([]uint64, words)), "size=%d at=%d value=%v", size, at, value)
+					assert.Zero(t, EqualsFloat64s(input, value, make([]uint64, words))[at/64]&(1<<(at%64)), "size=%d at=%d value=%v", size, at, value)
+					assert.Zero(t, BetweenFloat64s(input, -inf, inf, make([]uint64, words))[at/64]&(1<<(at%64)), "size=%d at=%d value=%v", size, at, value)
+				}
+
+				// NaN is only different from the other element, whichever side it is on
+				other := makeVector[float64](size)
+				other[size-1-at] = nan
+				for _, op := range []Comparison{Equal, NotEqual, Less, LessEqual, Greater, GreaterEqual} {
+					assert.Equal(t, compare(input, other, op, make([]uint64, words)), CompareFloat64s(input, other, op, make([]uint64, words)), "size=%d at=%d op=%v", size, at, op)
+					assert.Equal(t, compare(other, input, op, make([]uint64, words)), CompareFloat64s(other, input, op, make([]uint64, words)), "size=%d at=%d op=%v", size, at, op)
+					assert.Equal(t, op == NotEqual, CompareFloat64s(input, other, op, make([]uint64, words))[at/64]&(1<<(at%64)) != 0, "size=%d at=%d op=%v", size, at, op)
+				}
+			}
+
+			{ // NaN is only taken into the masked reductions when it is selected
+				input := makeVector[float64](size)
+				input[at] = nan
+				mask := make([]uint64, (size+63)/64)
+				for i := range mask {
+					mask[i] = ^uint64(0)
+				}
+
+				mask[at/64] &^= 1 << (at % 64)
+				lo, _ := MinFloat64sMasked(input, mask)
+				hi, _ := MaxFloat64sMasked(input, mask)
+				expectLo, _ := minMasked(input, mask)
+				expectHi, _ := maxMasked(input, mask)
+				same(expectLo, lo, "size=%d at=%d", size, at)
+				same(expectHi, hi, "size=%d at=%d", size, at)
+				same(sumMasked(input, mask), SumFloat64sMasked(input, mask), "size=%d at=%d", size, at)
+
+				mask[at/64] |= 1 << (at % 64)
+				lo, _ = MinFloat64sMasked(input, mask)
+				hi, _ = MaxFloat64sMasked(input, mask)
+				same(nan, lo, "size=%d at=%d", size, at)
+				same(nan, hi, "size=%d at=%d", size, at)
+				same(nan, SumFloat64sMasked(input, mask), "size=%d at=%d", size, at)
+			}
+		}
+
+		{ // All NaN
+			input := make([]float64, size)
+			for i := range input {
+				input[i] = nan
+			}
+			same(nan, NanMinFloat64s(input), "size=%d", size)
+			same(nan, NanMaxFloat64s(input), "size=%d", size)
+			assert.Equal(t, 0, ArgMinFloat64s(input), "size=%d", size)
+			assert.Equal(t, 0, ArgMaxFloat64s(input), "size=%d", size)
+		}
+	}
+}
+
+// ---------------------------------- Test Sum Float64 ----------------------------------
+
+func TestFloat64_SumOrder(t *testing.T) {
+	rangeTiers(t, testFloat64SumOrder)
+}
+
+func testFloat64SumOrder(t *testing.T) {
+	for _, size := range []int{0, 1, 7, lanesFloat64 - 1, lanesFloat64, lanesFloat64 + 1, 100, 255, 256, 257, 1000, 4096, 4099, 70000} {
+		input := make([]float64, size)
+		for i := range input {
+			input[i] = float64(math.Sin(float64(i)) * 1000)
+		}
+
+		// The result must be bit-for-bit the same as the generic one, on every tier
+		assert.Equal(t, sumKahan(input), SumFloat64sKahan(input), "size=%d", size)
+		assert.Equal(t, sumPairwise(input), SumFloat64sPairwise(input), "size=%d", size)
+		assert.Equal(t, SumFloat64sKahan(input), SumKahan(input), "size=%d", size)
+		assert.Equal(t, SumFloat64sPairwise(input), SumPairwise(input), "size=%d", size)
+	}
+
+	{ // Small values are not lost when added to a large one
+		tiny := float64(1e-17)
+		input := make([]float64, 10001)
+		input[0] = 1
+		for i := 1; i < len(input); i++ {
+			input[i] = tiny
+		}
+
+		expect := 1 + 10000*float64(tiny)
+		assert.InEpsilon(t, expect, float64(SumFloat64sKahan(input)), 1e-6)
+		assert.InEpsilon(t, expect, float64(SumFloat64sPairwise(input)), 1e-6)
+		assert.NotEqual(t, float64(1), SumFloat64sKahan(input))
+	}
+}
+
+
+// ---------------------------------- Test Div Float64 ----------------------------------
+
+func TestFloat64_Div(t *testing.T) {
+	rangeTiers(t, testFloat64Div)
+}
+
+func testFloat64Div(t *testing.T) {
+	same := func(expect, result []float64, value float64) {
+		for i := range expect {
+			if math.Float64bits(float64(expect[i])) != math.Float64bits(float64(result[i])) && !(expect[i] != expect[i] && result[i] != result[i]) {
+				assert.Fail(t, "quotients differ", "value=%v at=%d expect=%v result=%v", value, i, expect[i], result[i])
+				return
+			}
+		}
+	}
+
+	// Pseudo-random bit patterns, which also cover infinities, NaNs and subnormals, along with small integers
+	input := []float64{0, 1, 3, 6, float64(math.Inf(1)), float64(math.Inf(-1)), float64(math.NaN()), float64(math.Copysign(0, -1))}
+	for x := uint64(1); len(input) < 1000; x++ {
+		x := x * 0x9E3779B97F4A7C15
+		input = append(input, math.Float64frombits(x), float64(x>>59), float64(int64(x)>>53))
+	}
+
+	// Quotients are exact on every instruction set, the same as in Go
+	for _, value := range input {
+		expect := make([]float64, len(input))
+		for i, v := range input {
+			expect[i] = v / value
+		}
+		same(expect, DivScalarFloat64s(make([]float64, len(input)), input, value), value)
+
+		divisors := make([]float64, len(input))
+		for i := range divisors {
+			divisors[i] = value
+		}
+		same(expect, DivFloat64s(make([]float64, len(input)), input, divisors), value)
+		same(expect, Div(make([]float64, len(input)), input, divisors), value)
+
+		for i, v := range input {
+			expect[i] = value / v
+		}
+		same(expect, RevDivScalarFloat64s(make([]float64, len(input)), input, value), value)
+	}
 }
 
+
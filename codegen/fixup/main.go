@@ -0,0 +1,225 @@
+// Copyright (c) Roman Atachiants and contributors. All rights reserved.
+// Licensed under the MIT license. See LICENSE file in the project root for details.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// c2goasm loads the address of the constants of a function into BP, which clobbers the frame pointer
+// without saving it. This moves the constants onto a register which the function doesn't use instead,
+// and re-encodes the instructions that address them with asm2plan9s. If every register is taken, the
+// function gets a small frame, so that the assembler saves and restores BP around it.
+//
+// Usage: go run ./fixup <file.s>...
+
+// Registers which may hold the address of the constants, in the order of preference
+var candidates = []string{"r11", "r10", "r9", "r8", "rbx", "r12", "r13"}
+
+var (
+	text     = regexp.MustCompile(`^(TEXT .*\(SB\), )\$0-`)
+	leaq     = regexp.MustCompile(`^(\s*LEAQ LCDATA\d+<>\(SB\), )BP$`)
+	constant = regexp.MustCompile(`\b(\d+)\[rbp\]( /\* \[rip \+ [\w.]+\] \*/)`)
+	sized    = regexp.MustCompile(`\b(zmmword|yword|oword|qword|dword|word|byte) (\d+)\[(\w+)\]`)
+	register = regexp.MustCompile(`\b(r(?:8|9|1[0-5])[dwb]?|[re]?(?:ax|bx|cx|dx|si|di|bp|sp)|[abcd][lh]|(?:si|di|bp|sp)l)\b`)
+	goreg    = regexp.MustCompile(`\b(AX|BX|CX|DX|SI|DI|BP|SP|R(?:8|9|1[0-5]))\b`)
+)
+
+// Operand sizes which the assembler only understands in their long form
+var sizes = map[string]string{
+	"zmmword": "ZMMWORD PTR",
+	"yword":   "YMMWORD PTR",
+	"oword":   "XMMWORD PTR",
+	"qword":   "QWORD PTR",
+	"dword":   "DWORD PTR",
+	"word":    "WORD PTR",
+	"byte":    "BYTE PTR",
+}
+
+func main() {
+	for _, path := range os.Args[1:] {
+		if err := fixup(path); err != nil {
+			fmt.Fprintf(os.Stderr, "fixup: %s: %v\n", path, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// fixup rewrites the functions of an assembly file which keep their constants in BP
+func fixup(path string) error {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(body), "\n")
+	var encode []int
+	for _, fn := range functions(lines) {
+		encode = append(encode, rebase(lines[fn[0]:fn[1]], fn[0])...)
+	}
+	if len(encode) > 0 {
+		if err := reencode(lines, encode); err != nil {
+			return err
+		}
+	}
+
+	output := strings.Join(lines, "\n")
+	if output == string(body) {
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return err
+	}
+
+	return exec.Command("asmfmt", "-w", path).Run()
+}
+
+// functions returns the range of lines of every function in the file
+func functions(lines []string) (out [][2]int) {
+	start := -1
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "TEXT "):
+			if start >= 0 {
+				out = append(out, [2]int{start, i})
+			}
+			start = i
+		case start >= 0 && (strings.HasPrefix(line, "DATA ") || strings.HasPrefix(line, "GLOBL ")):
+			out = append(out, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		out = append(out, [2]int{start, len(lines)})
+	}
+	return
+}
+
+// rebase moves the constants of a function from BP onto a free register, and returns the
+// lines which need to be encoded again
+func rebase(fn []string, offset int) (encode []int) {
+	base, used := -1, map[string]bool{}
+	for i, line := range fn {
+		if leaq.MatchString(line) {
+			base = i
+			continue
+		}
+
+		code, comment, _ := strings.Cut(line, "//")
+		if strings.Contains(code, "$0x") {
+			code = "" // only the comment is readable on encoded lines
+		}
+		for _, r := range goreg.FindAllString(code, -1) {
+			used[canonical(strings.ToLower(r))] = true
+		}
+		for _, r := range register.FindAllString(constant.ReplaceAllString(comment, ""), -1) {
+			used[canonical(r)] = true
+		}
+	}
+
+	// Leave the function alone if BP is also used for anything but the constants
+	if base < 0 || used["rbp"] {
+		return nil
+	}
+
+	for _, r := range candidates {
+		if used[r] {
+			continue
+		}
+
+		fn[base] = leaq.ReplaceAllString(fn[base], "${1}"+plan9(r))
+		for i, line := range fn {
+			if constant.MatchString(line) {
+				fn[i] = constant.ReplaceAllString(line, "${1}["+r+"]${2}")
+				encode = append(encode, offset+i)
+			}
+		}
+		return encode
+	}
+
+	fn[0] = text.ReplaceAllString(fn[0], "${1}$$8-")
+	return nil
+}
+
+// canonical returns the name of the 64-bit register which the register is a part of
+func canonical(r string) string {
+	if len(r) > 1 && r[0] == 'r' && r[1] >= '0' && r[1] <= '9' {
+		return strings.TrimRight(r, "dwb")
+	}
+
+	for _, x := range []string{"ax", "bx", "cx", "dx"} {
+		switch r {
+		case x, "e" + x, "r" + x, x[:1] + "l", x[:1] + "h":
+			return "r" + x
+		}
+	}
+	for _, x := range []string{"si", "di", "bp", "sp"} {
+		switch r {
+		case x, "e" + x, "r" + x, x + "l":
+			return "r" + x
+		}
+	}
+	return r
+}
+
+// plan9 returns the name of a 64-bit register in the Go assembler
+func plan9(r string) string {
+	if r[1] >= '0' && r[1] <= '9' {
+		return strings.ToUpper(r)
+	}
+	return strings.ToUpper(r[1:])
+}
+
+// reencode runs asm2plan9s over the instructions of the given lines and replaces their encoding
+func reencode(lines []string, encode []int) error {
+	dir, err := os.MkdirTemp("", "fixup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	// asm2plan9s only replaces an existing encoding, and only knows the long form of operand sizes
+	var text []string
+	for _, i := range encode {
+		_, comment, _ := strings.Cut(lines[i], "//")
+		comment = strings.TrimSpace(comment)
+		text = append(text, "\tLONG $0x00000000 // "+sized.ReplaceAllStringFunc(comment, func(s string) string {
+			m := sized.FindStringSubmatch(s)
+			return fmt.Sprintf("%s [%s+%s]", sizes[m[1]], m[3], m[2])
+		}))
+	}
+
+	file := filepath.Join(dir, "fixup.s")
+	if err := os.WriteFile(file, []byte(strings.Join(text, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+
+	if out, err := exec.Command("asm2plan9s", file).CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, out)
+	}
+
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	for n, line := range strings.Split(strings.TrimSuffix(string(body), "\n"), "\n") {
+		code, _, _ := strings.Cut(line, "//")
+		if strings.Contains(code, "$0x00000000") {
+			return fmt.Errorf("unable to encode %q", text[n])
+		}
+
+		i := encode[n]
+		indent, _, _ := strings.Cut(lines[i], strings.TrimSpace(lines[i]))
+		_, comment, _ := strings.Cut(lines[i], "//")
+		lines[i] = indent + strings.TrimSpace(code) + " //" + comment
+	}
+	return nil
+}
@@ -8,14 +8,25 @@ function build_avx2_amd64 {
     ASM="simd_avx2_amd64.s"
     clang-14 -S -mavx2 -masm=intel $CLANG_OPTS -o $ASM $SRC
     c2goasm -a -f $ASM ../$ASM
+    go run ./fixup ../$ASM
+    rm $ASM
+}
+
+function build_sse_amd64 {
+    SRC="simd_sse_amd64.cpp"
+    ASM="simd_sse_amd64.s"
+    clang-14 -S -msse4.1 -masm=intel $CLANG_OPTS -o $ASM $SRC
+    c2goasm -a -f $ASM ../$ASM
+    go run ./fixup ../$ASM
     rm $ASM
 }
 
 function build_avx512_amd64 {
     SRC="simd_avx512_amd64.cpp"
     ASM="simd_avx512_amd64.s"
-    clang-14 -S -mavx512vl -masm=intel $CLANG_OPTS -o $ASM $SRC
+    clang-14 -S -mavx512f -mavx512bw -mavx512dq -mavx512vl -mprefer-vector-width=512 -masm=intel $CLANG_OPTS -o $ASM $SRC
     c2goasm -a -f $ASM ../$ASM
+    go run ./fixup ../$ASM
     rm $ASM
 }
 
@@ -28,5 +39,6 @@ function build_neon_arm64 {
 }
 
 build_avx2_amd64
-#build_avx512_amd64
+build_avx512_amd64
+build_sse_amd64
 #build_neon_arm64
@@ -17,58 +17,75 @@ import (
 var templates embed.FS
 
 type Type struct {
-	Name string
-	Type string
+	Name     string
+	Type     string
+	WideName string   // Name of the accumulator type, empty if already 64-bit
+	WideType string   // Type of the accumulator, empty if already 64-bit
+	AVX2     []string // Operations which measure faster on AVX2, so the AVX-512 table keeps those
 }
 
 var types = []Type{
-	{Name: "Uint8", Type: "uint8"},
-	{Name: "Uint16", Type: "uint16"},
-	{Name: "Uint32", Type: "uint32"},
+	{Name: "Uint8", Type: "uint8", WideName: "Uint64", WideType: "uint64", AVX2: []string{"sum", "dot", "mul", "mulScalar"}},
+	{Name: "Uint16", Type: "uint16", WideName: "Uint64", WideType: "uint64"},
+	{Name: "Uint32", Type: "uint32", WideName: "Uint64", WideType: "uint64", AVX2: []string{"sum"}},
 	{Name: "Uint64", Type: "uint64"},
-	{Name: "Int8", Type: "int8"},
-	{Name: "Int16", Type: "int16"},
-	{Name: "Int32", Type: "int32"},
+	{Name: "Int8", Type: "int8", WideName: "Int64", WideType: "int64", AVX2: []string{"dot", "mul", "mulScalar", "equals"}},
+	{Name: "Int16", Type: "int16", WideName: "Int64", WideType: "int64"},
+	{Name: "Int32", Type: "int32", WideName: "Int64", WideType: "int64"},
 	{Name: "Int64", Type: "int64"},
-	{Name: "Float32", Type: "float32"},
-	{Name: "Float64", Type: "float64"},
+	{Name: "Float32", Type: "float32", WideName: "Float64", WideType: "float64", AVX2: []string{"sum", "sumKahan"}},
+	{Name: "Float64", Type: "float64", AVX2: []string{"sum", "sumKahan"}},
 }
 
+// Platform-dependent types which are mapped onto the fixed-size ones
+var platforms = []Type{
+	{Name: "Int", Type: "int", WideName: "Int64", WideType: "int64"},
+	{Name: "Uint", Type: "uint", WideName: "Uint64", WideType: "uint64"},
+}
+
+// Vectorized instruction sets for amd64, in the order of preference
+var modes = []string{"avx512", "avx2", "sse"}
+
 func main() {
-	genCode("amd64", "avx2")
-	//genCode("amd64", "avx512")
+	for _, mode := range modes {
+		genCode("amd64", mode)
+	}
 	//genCode("arm64", "neon")
 	genFuncs("amd64")
 
-	if err := execute("../simd_generic.go", "generic.go", "---", "---"); err != nil {
+	if err := execute("../simd_generic.go", "generic.go", "---", "---", types); err != nil {
+		panic(err)
+	}
+
+	if err := execute("../simd_platform.go", "platform.go", "---", "---", platforms); err != nil {
 		panic(err)
 	}
 
-	if err := execute("../bench_test.go", "bench.go", "---", "---"); err != nil {
+	if err := execute("../bench_test.go", "bench.go", "---", "---", types); err != nil {
 		panic(err)
 	}
 }
 
 // Generates API functions and their tests
 func genFuncs(arch string) {
-	if err := execute(fmt.Sprintf("../simd_%s.go", arch), "funcs.go", arch, "---"); err != nil {
+	if err := execute(fmt.Sprintf("../simd_%s.go", arch), "funcs.go", arch, "---", types); err != nil {
 		panic(err)
 	}
 }
 
 // Generates the underling code for vectorization and companions
 func genCode(arch, mode string) {
-	if err := execute(fmt.Sprintf("simd_%s_%s.cpp", mode, arch), "source.cpp", arch, mode); err != nil {
+	if err := execute(fmt.Sprintf("simd_%s_%s.cpp", mode, arch), "source.cpp", arch, mode, types); err != nil {
 		panic(err)
 	}
 
-	if err := execute(fmt.Sprintf("../simd_%s_%s.go", mode, arch), "binding.go", arch, mode); err != nil {
+	if err := execute(fmt.Sprintf("../simd_%s_%s.go", mode, arch), "binding.go", arch, mode, types); err != nil {
 		panic(err)
 	}
 }
 
 // Executes the template
-func execute(dst, src, arch, mode string) error {
+func execute(dst, src, arch, mode string, types []Type) error {
 	body, err := templates.ReadFile("templates/" + src + ".tt")
 	if err != nil {
 		return err
@@ -87,10 +104,12 @@ func execute(dst, src, arch, mode string) error {
 	return cgen.Execute(out, struct {
 		Arch  string
 		Mode  string
+		Modes []string
 		Types []Type
 	}{
 		Arch:  arch,
 		Mode:  mode,
+		Modes: modes,
 		Types: types,
 	})
 }
@@ -0,0 +1,4756 @@
+// Copyright (c) Roman Atachiants and contributors. All rights reserved.
+// Licensed under the MIT license. See LICENSE file in the project root for details.
+
+#include <stdint.h>
+#include <immintrin.h>
+
+typedef float float32;
+typedef double float64;
+typedef int8_t int8;
+typedef int16_t int16;
+typedef int32_t int32;
+typedef int64_t int64;
+typedef uint8_t uint8;
+typedef uint16_t uint16;
+typedef uint32_t uint32;
+typedef uint64_t uint64;
+
+// NaNs are detected and produced through their bits, since -ffast-math assumes there are none
+typedef uint32 mask_float32;
+typedef uint64 mask_float64;
+
+static inline uint32 is_nan(float32 v) { uint32 b; __builtin_memcpy(&b, &v, sizeof(b)); return (b & 0x7fffffff) > 0x7f800000; }
+static inline uint64 is_nan(float64 v) { uint64 b; __builtin_memcpy(&b, &v, sizeof(b)); return (b & 0x7fffffffffffffff) > 0x7ff0000000000000; }
+static inline void set_nan(float32 *v) { uint32 b = 0x7fc00000; __builtin_memcpy(v, &b, sizeof(b)); }
+static inline void set_nan(float64 *v) { uint64 b = 0x7ff8000000000000; __builtin_memcpy(v, &b, sizeof(b)); }
+static inline float32 infinity(float32 *) { float32 v; uint32 b = 0x7f800000; __builtin_memcpy(&v, &b, sizeof(b)); return v; }
+static inline float64 infinity(float64 *) { float64 v; uint64 b = 0x7ff0000000000000; __builtin_memcpy(&v, &b, sizeof(b)); return v; }
+
+// Integers are divided by a scalar with a multiply and shift by its reciprocal, which is computed by the
+// caller. The reciprocal is unsigned, so signed integers are divided as absolute values and negated back.
+typedef uint8 unsigned_int8;
+typedef uint16 unsigned_int16;
+typedef uint32 unsigned_int32;
+typedef uint64 unsigned_int64;
+typedef uint8 unsigned_uint8;
+typedef uint16 unsigned_uint16;
+typedef uint32 unsigned_uint32;
+typedef uint64 unsigned_uint64;
+
+static inline uint8 mulhi(uint8 a, uint8 b) { return (uint16)a * b >> 8; }
+static inline uint16 mulhi(uint16 a, uint16 b) { return (uint32)a * b >> 16; }
+static inline uint32 mulhi(uint32 a, uint32 b) { return (uint64)a * b >> 32; }
+static inline uint64 mulhi(uint64 a, uint64 b) { return (unsigned __int128)a * b >> 64; }
+
+// Comparisons produce one bit per element, 64 elements at a time. The results are first computed as bytes,
+// which vectorizes well, and then packed eight at a time by multiplying so that each byte ends up as a bit.
+// Without AVX-512 the 64-bit elements cannot be narrowed to bytes cheaply, so their bits are set directly.
+static inline uint64_t pack(uint8_t *match) {
+    uint64_t bits = 0;
+    for (int i = 0; i < 8; i++) {
+        uint64_t bytes;
+        __builtin_memcpy(&bytes, match + i * 8, sizeof(bytes));
+        bits |= (bytes * 0x0102040810204080 >> 56) << (i * 8);
+    }
+    return bits;
+}
+
+#define COMPARE_BYTES(match) uint8_t bytes[64]; for (int j = 0; j < 64; j++) bytes[j] = match; bitmap[i / 64] = pack(bytes)
+#define COMPARE_BITS(match) uint64_t bits = 0; for (int j = 0; j < 64; j++) bits |= (uint64_t)(match) << j; bitmap[i / 64] = bits
+
+// Blend spreads the bits of the mask over the lanes of a vector, so that every lane which has its own bit set
+// becomes all ones and can select with a byte blend.
+template <int size> static inline __m128i blend_mask(uint64_t bits);
+template <> inline __m128i blend_mask<1>(uint64_t bits) {
+    __m128i spread = _mm_shuffle_epi8(_mm_cvtsi32_si128((uint32_t)bits), _mm_setr_epi8(0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1));
+    __m128i bit = _mm_set1_epi64x(0x8040201008040201);
+    return _mm_cmpeq_epi8(_mm_and_si128(spread, bit), bit);
+}
+template <> inline __m128i blend_mask<2>(uint64_t bits) {
+    __m128i bit = _mm_setr_epi16(1, 2, 4, 8, 16, 32, 64, 128);
+    return _mm_cmpeq_epi16(_mm_and_si128(_mm_set1_epi16((uint8_t)bits), bit), bit);
+}
+template <> inline __m128i blend_mask<4>(uint64_t bits) {
+    __m128i bit = _mm_setr_epi32(1, 2, 4, 8);
+    return _mm_cmpeq_epi32(_mm_and_si128(_mm_set1_epi32(bits & 15), bit), bit);
+}
+template <> inline __m128i blend_mask<8>(uint64_t bits) {
+    __m128i bit = _mm_set_epi64x(2, 1);
+    return _mm_cmpeq_epi64(_mm_and_si128(_mm_set1_epi64x(bits & 3), bit), bit);
+}
+
+// Compensated and pairwise sums keep a separate lane for every element of a ROW bytes wide row, which
+// is the widest vector register, and add BLOCK rows at a time in the pairwise sum. This way the order of
+// additions, and therefore the result, does not depend on how many lanes fit into a VECTOR register.
+// The vectors are aligned as their elements, since neither the input nor the output is aligned any further.
+#define ROW 64
+#define BLOCK 16
+#define VECTOR 16
+
+typedef float32 vector_float32 __attribute__((vector_size(VECTOR), aligned(4)));
+typedef float64 vector_float64 __attribute__((vector_size(VECTOR), aligned(8)));
+
+// Widening sums add up bytes eight at a time into 64-bit lanes with a sum of absolute differences against
+// zero, and 16-bit elements in pairs into 32-bit lanes with a multiply-add by one, which are then widened
+// to 64-bit before they can overflow. Both treat the elements as one signedness, so the other one is
+// flipped into it with an xor of the sign bit, and the bias this adds is taken back out of the result.
+typedef __m128i vector_int;
+static inline vector_int load(const void *p) { return _mm_loadu_si128((const __m128i *)p); }
+static inline vector_int flip8(vector_int v) { return _mm_xor_si128(v, _mm_set1_epi8(-128)); }
+static inline vector_int flip16(vector_int v) { return _mm_xor_si128(v, _mm_set1_epi16(-32768)); }
+static inline vector_int add32(vector_int a, vector_int b) { return _mm_add_epi32(a, b); }
+static inline vector_int add64(vector_int a, vector_int b) { return _mm_add_epi64(a, b); }
+static inline vector_int sum_bytes(vector_int v) { return _mm_sad_epu8(v, _mm_setzero_si128()); }
+static inline vector_int sum_pairs(vector_int v) { return _mm_madd_epi16(v, _mm_set1_epi16(1)); }
+static inline vector_int widen(vector_int v) {
+    return _mm_add_epi64(_mm_cvtepi32_epi64(v), _mm_cvtepi32_epi64(_mm_unpackhi_epi64(v, v)));
+}
+static inline int64_t reduce(vector_int v) { return _mm_cvtsi128_si64(v) + _mm_extract_epi64(v, 1); }
+
+// Float reductions keep the running minimum or maximum in vectors and look for NaNs with an unordered
+// compare of every pair of vectors, which is folded into a mask.
+typedef __m128 lanes_float32;
+typedef __m128d lanes_float64;
+typedef __m128 nans_float32;
+typedef __m128d nans_float64;
+static inline __m128 load(const float32 *p) { return _mm_loadu_ps(p); }
+static inline __m128d load(const float64 *p) { return _mm_loadu_pd(p); }
+static inline __m128 splat(float32 v) { return _mm_set1_ps(v); }
+static inline __m128d splat(float64 v) { return _mm_set1_pd(v); }
+static inline __m128 lower(__m128 a, __m128 b) { return _mm_min_ps(a, b); }
+static inline __m128d lower(__m128d a, __m128d b) { return _mm_min_pd(a, b); }
+static inline __m128 upper(__m128 a, __m128 b) { return _mm_max_ps(a, b); }
+static inline __m128d upper(__m128d a, __m128d b) { return _mm_max_pd(a, b); }
+static inline __m128 unordered(__m128 a, __m128 b) { return _mm_cmpunord_ps(a, b); }
+static inline __m128d unordered(__m128d a, __m128d b) { return _mm_cmpunord_pd(a, b); }
+static inline __m128 ordered(__m128 v) { return _mm_cmpord_ps(v, v); }
+static inline __m128d ordered(__m128d v) { return _mm_cmpord_pd(v, v); }
+static inline __m128 either(__m128 a, __m128 b) { return _mm_or_ps(a, b); }
+static inline __m128d either(__m128d a, __m128d b) { return _mm_or_pd(a, b); }
+static inline bool any(__m128 m) { return _mm_movemask_ps(m) != 0; }
+static inline bool any(__m128d m) { return _mm_movemask_pd(m) != 0; }
+static inline __m128 replace(__m128 v, __m128 m, __m128 value) { return _mm_blendv_ps(v, value, m); }
+static inline __m128d replace(__m128d v, __m128d m, __m128d value) { return _mm_blendv_pd(v, value, m); }
+
+// The elements which do not fill a vector, and the lanes of the vectors at the end, are taken one at a time.
+static inline float32 lower(float32 a, float32 b) { return b < a ? b : a; }
+static inline float64 lower(float64 a, float64 b) { return b < a ? b : a; }
+static inline float32 upper(float32 a, float32 b) { return b > a ? b : a; }
+static inline float64 upper(float64 a, float64 b) { return b > a ? b : a; }
+template <typename T, typename V> static inline T lower(T min, V v) {
+    T lane[sizeof(V) / sizeof(T)];
+    __builtin_memcpy(lane, &v, sizeof(v));
+    for (unsigned i = 0; i < sizeof(V) / sizeof(T); i++) {
+        min = lower(min, lane[i]);
+    }
+    return min;
+}
+template <typename T, typename V> static inline T upper(T max, V v) {
+    T lane[sizeof(V) / sizeof(T)];
+    __builtin_memcpy(lane, &v, sizeof(v));
+    for (unsigned i = 0; i < sizeof(V) / sizeof(T); i++) {
+        max = upper(max, lane[i]);
+    }
+    return max;
+}
+
+// Either of the zeros may come out of the vectors, so a zero result is given its sign afterwards, the same
+// as math.Min and math.Max would: the smallest value is -0 if any of the elements is -0, and the largest is
+// +0 if any of them is +0. Only then are the elements, or those selected by the mask, looked at again.
+static inline bool is_zero(float32 v) { uint32 b; __builtin_memcpy(&b, &v, sizeof(b)); return (b & 0x7fffffff) == 0; }
+static inline bool is_zero(float64 v) { uint64 b; __builtin_memcpy(&b, &v, sizeof(b)); return (b & 0x7fffffffffffffff) == 0; }
+static inline void set_zero(float32 *v, bool negative) { uint32 b = negative ? 0x80000000 : 0; __builtin_memcpy(v, &b, sizeof(b)); }
+static inline void set_zero(float64 *v, bool negative) { uint64 b = negative ? 0x8000000000000000 : 0; __builtin_memcpy(v, &b, sizeof(b)); }
+template <typename B, typename T> static inline bool has_zero(T *input, uint64_t *mask, uint64_t size, bool negative) {
+    B zero = negative ? (B)1 << (sizeof(B) * 8 - 1) : 0, found = 0;
+    for (uint64_t i = 0; i < size; i++) {
+        B b;
+        __builtin_memcpy(&b, input + i, sizeof(b));
+        found |= (b == zero) & (mask == 0 || (mask[i / 64] >> (i % 64) & 1));
+    }
+    return found != 0;
+}
+
+
+// ---------------------------------- Uint8 ----------------------------------
+
+extern "C" void uint8_sse_sum(uint8 *input, uint8 *result, uint64_t size) {
+    uint8 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void uint8_sse_sum_wide(uint8 *input, uint64 *result, uint64_t size) {
+    const uint64_t width = sizeof(vector_int), step = 4 * width;
+    vector_int s[4] = {};
+    uint64_t i = 0;
+    for (; i + step <= size; i += step) {
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 4; k++) {
+            s[k] = add64(s[k], sum_bytes(load(input + i + k * width)));
+        }
+    }
+    uint64 sum = reduce(add64(add64(s[0], s[1]), add64(s[2], s[3])));
+    for (; i < size; i++) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint8_sse_min(uint8 *input, uint8 *result, uint64_t size) {
+    uint8 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint8_sse_max(uint8 *input, uint8 *result, uint64_t size) {
+    uint8 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint8_sse_minmax(uint8 *input, uint8 *min, uint8 *max, uint64_t size) {
+    uint8 lo = input[0];
+    uint8 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void uint8_sse_argmin(uint8 *input, uint64_t *result, uint64_t size) {
+    uint8 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint8 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint8 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint8_sse_argmax(uint8 *input, uint64_t *result, uint64_t size) {
+    uint8 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint8 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint8 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint8_sse_dot(uint8 *input1, uint8 *input2, uint8 *result, uint64_t size) {
+    uint8 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint8_sse_add(uint8 *input1, uint8 *input2, uint8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void uint8_sse_sub(uint8 *input1, uint8 *input2, uint8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void uint8_sse_mul(uint8 *input1, uint8 *input2, uint8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void uint8_sse_div(uint8 *input1, uint8 *input2, uint8 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint8_sse_add_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void uint8_sse_sub_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void uint8_sse_rsub_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void uint8_sse_mul_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void uint8_sse_div_scalar(uint8 *input, unsigned_uint8 *magic, uint8 *output, uint64_t size) {
+    const unsigned_uint8 m = magic[0], s = magic[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 n = input[i];
+        uint8 q = mulhi(n, m);
+        output[i] = ((((uint8)(n - q)) >> 1) + q) >> s;
+    }
+}
+
+extern "C" void uint8_sse_rdiv_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t *index, uint64_t size) {
+    uint8 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint8_sse_min_of(uint8 *input1, uint8 *input2, uint8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void uint8_sse_max_of(uint8 *input1, uint8 *input2, uint8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void uint8_sse_min_of_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void uint8_sse_max_of_scalar(uint8 *input, uint8 *value, uint8 *output, uint64_t size) {
+    uint8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void uint8_sse_clamp(uint8 *input, uint8 *bounds, uint8 *output, uint64_t size) {
+    uint8 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint8 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void uint8_sse_equals(uint8 *input, uint8 *value, uint64_t *bitmap, uint64_t size) {
+    uint8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void uint8_sse_less(uint8 *input, uint8 *value, uint64_t *bitmap, uint64_t size) {
+    uint8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void uint8_sse_greater(uint8 *input, uint8 *value, uint64_t *bitmap, uint64_t size) {
+    uint8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void uint8_sse_between(uint8 *input, uint8 *bounds, uint64_t *bitmap, uint64_t size) {
+    uint8 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void uint8_sse_compare_equal(uint8 *input1, uint8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void uint8_sse_compare_less(uint8 *input1, uint8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void uint8_sse_compare_less_equal(uint8 *input1, uint8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void uint8_sse_sum_masked(uint8 *input, uint64_t *mask, uint8 *result, uint64_t size) {
+    uint8 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void uint8_sse_min_masked(uint8 *input, uint64_t *mask, uint8 *result, uint64_t size) {
+    uint8 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint8 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint8 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint8_sse_max_masked(uint8 *input, uint64_t *mask, uint8 *result, uint64_t size) {
+    uint8 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint8 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint8 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint8_sse_blend(uint8 *input1, uint8 *input2, uint64_t *mask, uint8 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint8);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint8)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void uint8_sse_blend_scalar(uint8 *input, uint8 *value, uint64_t *mask, uint8 *output, uint64_t size) {
+    typedef uint8 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint8);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint8)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Uint16 ----------------------------------
+
+extern "C" void uint16_sse_sum(uint16 *input, uint16 *result, uint64_t size) {
+    uint16 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void uint16_sse_sum_wide(uint16 *input, uint64 *result, uint64_t size) {
+    const uint64_t width = sizeof(vector_int) / sizeof(uint16), step = 4 * width;
+    vector_int total = {};
+    uint64_t i = 0;
+    while (i + step <= size) {
+        // A pair adds at most 2^16 to a 32-bit lane, so a lane can take 2^15 of them before it overflows
+        uint64_t end = i + (size - i) / step * step;
+        if (end - i > step << 15) {
+            end = i + (step << 15);
+        }
+
+        vector_int s[4] = {};
+        for (; i < end; i += step) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                s[k] = add32(s[k], sum_pairs(flip16(load(input + i + k * width))));
+            }
+        }
+        total = add64(total, add64(add64(widen(s[0]), widen(s[1])), add64(widen(s[2]), widen(s[3]))));
+    }
+    uint64 sum = reduce(total) + 32768 * i;
+    for (; i < size; i++) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint16_sse_min(uint16 *input, uint16 *result, uint64_t size) {
+    uint16 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint16_sse_max(uint16 *input, uint16 *result, uint64_t size) {
+    uint16 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint16_sse_minmax(uint16 *input, uint16 *min, uint16 *max, uint64_t size) {
+    uint16 lo = input[0];
+    uint16 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void uint16_sse_argmin(uint16 *input, uint64_t *result, uint64_t size) {
+    uint16 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint16 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint16 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint16_sse_argmax(uint16 *input, uint64_t *result, uint64_t size) {
+    uint16 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint16 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint16 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint16_sse_dot(uint16 *input1, uint16 *input2, uint16 *result, uint64_t size) {
+    uint16 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint16_sse_add(uint16 *input1, uint16 *input2, uint16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void uint16_sse_sub(uint16 *input1, uint16 *input2, uint16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void uint16_sse_mul(uint16 *input1, uint16 *input2, uint16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void uint16_sse_div(uint16 *input1, uint16 *input2, uint16 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint16_sse_add_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void uint16_sse_sub_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void uint16_sse_rsub_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void uint16_sse_mul_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void uint16_sse_div_scalar(uint16 *input, unsigned_uint16 *magic, uint16 *output, uint64_t size) {
+    const unsigned_uint16 m = magic[0], s = magic[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 n = input[i];
+        uint16 q = mulhi(n, m);
+        output[i] = ((((uint16)(n - q)) >> 1) + q) >> s;
+    }
+}
+
+extern "C" void uint16_sse_rdiv_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t *index, uint64_t size) {
+    uint16 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint16_sse_min_of(uint16 *input1, uint16 *input2, uint16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void uint16_sse_max_of(uint16 *input1, uint16 *input2, uint16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void uint16_sse_min_of_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void uint16_sse_max_of_scalar(uint16 *input, uint16 *value, uint16 *output, uint64_t size) {
+    uint16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void uint16_sse_clamp(uint16 *input, uint16 *bounds, uint16 *output, uint64_t size) {
+    uint16 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint16 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void uint16_sse_equals(uint16 *input, uint16 *value, uint64_t *bitmap, uint64_t size) {
+    uint16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void uint16_sse_less(uint16 *input, uint16 *value, uint64_t *bitmap, uint64_t size) {
+    uint16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void uint16_sse_greater(uint16 *input, uint16 *value, uint64_t *bitmap, uint64_t size) {
+    uint16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void uint16_sse_between(uint16 *input, uint16 *bounds, uint64_t *bitmap, uint64_t size) {
+    uint16 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void uint16_sse_compare_equal(uint16 *input1, uint16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void uint16_sse_compare_less(uint16 *input1, uint16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void uint16_sse_compare_less_equal(uint16 *input1, uint16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void uint16_sse_sum_masked(uint16 *input, uint64_t *mask, uint16 *result, uint64_t size) {
+    uint16 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void uint16_sse_min_masked(uint16 *input, uint64_t *mask, uint16 *result, uint64_t size) {
+    uint16 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint16 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint16 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint16_sse_max_masked(uint16 *input, uint64_t *mask, uint16 *result, uint64_t size) {
+    uint16 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint16 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint16 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint16_sse_blend(uint16 *input1, uint16 *input2, uint64_t *mask, uint16 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint16);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint16)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void uint16_sse_blend_scalar(uint16 *input, uint16 *value, uint64_t *mask, uint16 *output, uint64_t size) {
+    typedef uint16 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint16);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint16)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Uint32 ----------------------------------
+
+extern "C" void uint32_sse_sum(uint32 *input, uint32 *result, uint64_t size) {
+    uint32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void uint32_sse_sum_wide(uint32 *input, uint64 *result, uint64_t size) {
+    uint64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += (uint64)input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint32_sse_min(uint32 *input, uint32 *result, uint64_t size) {
+    uint32 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint32_sse_max(uint32 *input, uint32 *result, uint64_t size) {
+    uint32 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint32_sse_minmax(uint32 *input, uint32 *min, uint32 *max, uint64_t size) {
+    uint32 lo = input[0];
+    uint32 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void uint32_sse_argmin(uint32 *input, uint64_t *result, uint64_t size) {
+    uint32 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint32 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint32_sse_argmax(uint32 *input, uint64_t *result, uint64_t size) {
+    uint32 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint32 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint32_sse_dot(uint32 *input1, uint32 *input2, uint32 *result, uint64_t size) {
+    uint32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint32_sse_add(uint32 *input1, uint32 *input2, uint32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void uint32_sse_sub(uint32 *input1, uint32 *input2, uint32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void uint32_sse_mul(uint32 *input1, uint32 *input2, uint32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void uint32_sse_div(uint32 *input1, uint32 *input2, uint32 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint32_sse_add_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void uint32_sse_sub_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void uint32_sse_rsub_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void uint32_sse_mul_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void uint32_sse_div_scalar(uint32 *input, unsigned_uint32 *magic, uint32 *output, uint64_t size) {
+    const unsigned_uint32 m = magic[0], s = magic[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 n = input[i];
+        uint32 q = mulhi(n, m);
+        output[i] = ((((uint32)(n - q)) >> 1) + q) >> s;
+    }
+}
+
+extern "C" void uint32_sse_rdiv_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t *index, uint64_t size) {
+    uint32 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint32_sse_min_of(uint32 *input1, uint32 *input2, uint32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void uint32_sse_max_of(uint32 *input1, uint32 *input2, uint32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void uint32_sse_min_of_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void uint32_sse_max_of_scalar(uint32 *input, uint32 *value, uint32 *output, uint64_t size) {
+    uint32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void uint32_sse_clamp(uint32 *input, uint32 *bounds, uint32 *output, uint64_t size) {
+    uint32 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint32 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void uint32_sse_equals(uint32 *input, uint32 *value, uint64_t *bitmap, uint64_t size) {
+    uint32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void uint32_sse_less(uint32 *input, uint32 *value, uint64_t *bitmap, uint64_t size) {
+    uint32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void uint32_sse_greater(uint32 *input, uint32 *value, uint64_t *bitmap, uint64_t size) {
+    uint32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void uint32_sse_between(uint32 *input, uint32 *bounds, uint64_t *bitmap, uint64_t size) {
+    uint32 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void uint32_sse_compare_equal(uint32 *input1, uint32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void uint32_sse_compare_less(uint32 *input1, uint32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void uint32_sse_compare_less_equal(uint32 *input1, uint32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void uint32_sse_sum_masked(uint32 *input, uint64_t *mask, uint32 *result, uint64_t size) {
+    uint32 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void uint32_sse_min_masked(uint32 *input, uint64_t *mask, uint32 *result, uint64_t size) {
+    uint32 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint32 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint32 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint32_sse_max_masked(uint32 *input, uint64_t *mask, uint32 *result, uint64_t size) {
+    uint32 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint32 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint32 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint32_sse_compress(uint32 *input, uint64_t *mask, uint32 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void uint32_sse_blend(uint32 *input1, uint32 *input2, uint64_t *mask, uint32 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint32)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void uint32_sse_blend_scalar(uint32 *input, uint32 *value, uint64_t *mask, uint32 *output, uint64_t size) {
+    typedef uint32 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint32)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Uint64 ----------------------------------
+
+extern "C" void uint64_sse_sum(uint64 *input, uint64 *result, uint64_t size) {
+    uint64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void uint64_sse_min(uint64 *input, uint64 *result, uint64_t size) {
+    uint64 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint64_sse_max(uint64 *input, uint64 *result, uint64_t size) {
+    uint64 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint64_sse_minmax(uint64 *input, uint64 *min, uint64 *max, uint64_t size) {
+    uint64 lo = input[0];
+    uint64 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void uint64_sse_argmin(uint64 *input, uint64_t *result, uint64_t size) {
+    uint64 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint64 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint64_sse_argmax(uint64 *input, uint64_t *result, uint64_t size) {
+    uint64 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        uint64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        uint64 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void uint64_sse_dot(uint64 *input1, uint64 *input2, uint64 *result, uint64_t size) {
+    uint64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void uint64_sse_add(uint64 *input1, uint64 *input2, uint64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void uint64_sse_sub(uint64 *input1, uint64 *input2, uint64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void uint64_sse_mul(uint64 *input1, uint64 *input2, uint64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void uint64_sse_div(uint64 *input1, uint64 *input2, uint64 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint64_sse_add_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void uint64_sse_sub_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void uint64_sse_rsub_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void uint64_sse_mul_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void uint64_sse_div_scalar(uint64 *input, unsigned_uint64 *magic, uint64 *output, uint64_t size) {
+    const unsigned_uint64 m = magic[0], s = magic[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 n = input[i];
+        uint64 q = mulhi(n, m);
+        output[i] = ((((uint64)(n - q)) >> 1) + q) >> s;
+    }
+}
+
+extern "C" void uint64_sse_rdiv_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t *index, uint64_t size) {
+    uint64 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void uint64_sse_min_of(uint64 *input1, uint64 *input2, uint64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void uint64_sse_max_of(uint64 *input1, uint64 *input2, uint64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void uint64_sse_min_of_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void uint64_sse_max_of_scalar(uint64 *input, uint64 *value, uint64 *output, uint64_t size) {
+    uint64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void uint64_sse_clamp(uint64 *input, uint64 *bounds, uint64 *output, uint64_t size) {
+    uint64 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        uint64 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void uint64_sse_equals(uint64 *input, uint64 *value, uint64_t *bitmap, uint64_t size) {
+    uint64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] == k);
+    }
+}
+
+extern "C" void uint64_sse_less(uint64 *input, uint64 *value, uint64_t *bitmap, uint64_t size) {
+    uint64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] < k);
+    }
+}
+
+extern "C" void uint64_sse_greater(uint64 *input, uint64 *value, uint64_t *bitmap, uint64_t size) {
+    uint64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] > k);
+    }
+}
+
+extern "C" void uint64_sse_between(uint64 *input, uint64 *bounds, uint64_t *bitmap, uint64_t size) {
+    uint64 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void uint64_sse_compare_equal(uint64 *input1, uint64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void uint64_sse_compare_less(uint64 *input1, uint64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void uint64_sse_compare_less_equal(uint64 *input1, uint64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void uint64_sse_sum_masked(uint64 *input, uint64_t *mask, uint64 *result, uint64_t size) {
+    uint64 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void uint64_sse_min_masked(uint64 *input, uint64_t *mask, uint64 *result, uint64_t size) {
+    uint64 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint64 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint64 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void uint64_sse_max_masked(uint64 *input, uint64_t *mask, uint64 *result, uint64_t size) {
+    uint64 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                uint64 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            uint64 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void uint64_sse_compress(uint64 *input, uint64_t *mask, uint64 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void uint64_sse_blend(uint64 *input1, uint64 *input2, uint64_t *mask, uint64 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint64)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void uint64_sse_blend_scalar(uint64 *input, uint64 *value, uint64_t *mask, uint64 *output, uint64_t size) {
+    typedef uint64 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(uint64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(uint64)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Int8 ----------------------------------
+
+extern "C" void int8_sse_sum(int8 *input, int8 *result, uint64_t size) {
+    int8 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void int8_sse_sum_wide(int8 *input, int64 *result, uint64_t size) {
+    const uint64_t width = sizeof(vector_int), step = 4 * width;
+    vector_int s[4] = {};
+    uint64_t i = 0;
+    for (; i + step <= size; i += step) {
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 4; k++) {
+            s[k] = add64(s[k], sum_bytes(flip8(load(input + i + k * width))));
+        }
+    }
+    int64 sum = reduce(add64(add64(s[0], s[1]), add64(s[2], s[3]))) - 128 * (int64_t)i;
+    for (; i < size; i++) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int8_sse_min(int8 *input, int8 *result, uint64_t size) {
+    int8 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int8_sse_max(int8 *input, int8 *result, uint64_t size) {
+    int8 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int8_sse_minmax(int8 *input, int8 *min, int8 *max, uint64_t size) {
+    int8 lo = input[0];
+    int8 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void int8_sse_argmin(int8 *input, uint64_t *result, uint64_t size) {
+    int8 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int8 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int8 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int8_sse_argmax(int8 *input, uint64_t *result, uint64_t size) {
+    int8 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int8 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int8 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int8_sse_dot(int8 *input1, int8 *input2, int8 *result, uint64_t size) {
+    int8 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int8_sse_add(int8 *input1, int8 *input2, int8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void int8_sse_sub(int8 *input1, int8 *input2, int8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void int8_sse_mul(int8 *input1, int8 *input2, int8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void int8_sse_div(int8 *input1, int8 *input2, int8 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input2[i] == -1 ? (unsigned_int8)0 - input1[i] : input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void int8_sse_add_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void int8_sse_sub_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void int8_sse_rsub_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void int8_sse_mul_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void int8_sse_div_scalar(int8 *input, unsigned_int8 *magic, int8 *output, uint64_t size) {
+    const unsigned_int8 m = magic[0], s = magic[1];
+    const int8 sign = magic[2];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 neg = input[i] >> (sizeof(int8) * 8 - 1);
+        unsigned_int8 n = ((unsigned_int8)input[i] ^ neg) - neg;
+        unsigned_int8 q = mulhi(n, m);
+        unsigned_int8 t = (((unsigned_int8)(n - q) >> 1) + q) >> s;
+        output[i] = (t ^ (neg ^ sign)) - (neg ^ sign);
+    }
+}
+
+extern "C" void int8_sse_rdiv_scalar(int8 *input, int8 *value, int8 *output, uint64_t *index, uint64_t size) {
+    int8 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = input[i] == -1 ? (unsigned_int8)0 - k : k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void int8_sse_min_of(int8 *input1, int8 *input2, int8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void int8_sse_max_of(int8 *input1, int8 *input2, int8 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void int8_sse_min_of_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void int8_sse_max_of_scalar(int8 *input, int8 *value, int8 *output, uint64_t size) {
+    int8 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void int8_sse_clamp(int8 *input, int8 *bounds, int8 *output, uint64_t size) {
+    int8 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int8 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void int8_sse_equals(int8 *input, int8 *value, uint64_t *bitmap, uint64_t size) {
+    int8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void int8_sse_less(int8 *input, int8 *value, uint64_t *bitmap, uint64_t size) {
+    int8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void int8_sse_greater(int8 *input, int8 *value, uint64_t *bitmap, uint64_t size) {
+    int8 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void int8_sse_between(int8 *input, int8 *bounds, uint64_t *bitmap, uint64_t size) {
+    int8 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void int8_sse_compare_equal(int8 *input1, int8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void int8_sse_compare_less(int8 *input1, int8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void int8_sse_compare_less_equal(int8 *input1, int8 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void int8_sse_sum_masked(int8 *input, uint64_t *mask, int8 *result, uint64_t size) {
+    int8 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void int8_sse_min_masked(int8 *input, uint64_t *mask, int8 *result, uint64_t size) {
+    int8 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int8 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int8 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int8_sse_max_masked(int8 *input, uint64_t *mask, int8 *result, uint64_t size) {
+    int8 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int8 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int8 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int8_sse_blend(int8 *input1, int8 *input2, uint64_t *mask, int8 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int8);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int8)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void int8_sse_blend_scalar(int8 *input, int8 *value, uint64_t *mask, int8 *output, uint64_t size) {
+    typedef int8 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int8);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int8)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Int16 ----------------------------------
+
+extern "C" void int16_sse_sum(int16 *input, int16 *result, uint64_t size) {
+    int16 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void int16_sse_sum_wide(int16 *input, int64 *result, uint64_t size) {
+    const uint64_t width = sizeof(vector_int) / sizeof(int16), step = 4 * width;
+    vector_int total = {};
+    uint64_t i = 0;
+    while (i + step <= size) {
+        // A pair adds at most 2^16 to a 32-bit lane, so a lane can take 2^15 of them before it overflows
+        uint64_t end = i + (size - i) / step * step;
+        if (end - i > step << 15) {
+            end = i + (step << 15);
+        }
+
+        vector_int s[4] = {};
+        for (; i < end; i += step) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                s[k] = add32(s[k], sum_pairs(load(input + i + k * width)));
+            }
+        }
+        total = add64(total, add64(add64(widen(s[0]), widen(s[1])), add64(widen(s[2]), widen(s[3]))));
+    }
+    int64 sum = reduce(total);
+    for (; i < size; i++) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int16_sse_min(int16 *input, int16 *result, uint64_t size) {
+    int16 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int16_sse_max(int16 *input, int16 *result, uint64_t size) {
+    int16 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int16_sse_minmax(int16 *input, int16 *min, int16 *max, uint64_t size) {
+    int16 lo = input[0];
+    int16 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void int16_sse_argmin(int16 *input, uint64_t *result, uint64_t size) {
+    int16 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int16 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int16 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int16_sse_argmax(int16 *input, uint64_t *result, uint64_t size) {
+    int16 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int16 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int16 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int16_sse_dot(int16 *input1, int16 *input2, int16 *result, uint64_t size) {
+    int16 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int16_sse_add(int16 *input1, int16 *input2, int16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void int16_sse_sub(int16 *input1, int16 *input2, int16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void int16_sse_mul(int16 *input1, int16 *input2, int16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void int16_sse_div(int16 *input1, int16 *input2, int16 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input2[i] == -1 ? (unsigned_int16)0 - input1[i] : input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void int16_sse_add_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void int16_sse_sub_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void int16_sse_rsub_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void int16_sse_mul_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void int16_sse_div_scalar(int16 *input, unsigned_int16 *magic, int16 *output, uint64_t size) {
+    const unsigned_int16 m = magic[0], s = magic[1];
+    const int16 sign = magic[2];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 neg = input[i] >> (sizeof(int16) * 8 - 1);
+        unsigned_int16 n = ((unsigned_int16)input[i] ^ neg) - neg;
+        unsigned_int16 q = mulhi(n, m);
+        unsigned_int16 t = (((unsigned_int16)(n - q) >> 1) + q) >> s;
+        output[i] = (t ^ (neg ^ sign)) - (neg ^ sign);
+    }
+}
+
+extern "C" void int16_sse_rdiv_scalar(int16 *input, int16 *value, int16 *output, uint64_t *index, uint64_t size) {
+    int16 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = input[i] == -1 ? (unsigned_int16)0 - k : k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void int16_sse_min_of(int16 *input1, int16 *input2, int16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void int16_sse_max_of(int16 *input1, int16 *input2, int16 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void int16_sse_min_of_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void int16_sse_max_of_scalar(int16 *input, int16 *value, int16 *output, uint64_t size) {
+    int16 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void int16_sse_clamp(int16 *input, int16 *bounds, int16 *output, uint64_t size) {
+    int16 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int16 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void int16_sse_equals(int16 *input, int16 *value, uint64_t *bitmap, uint64_t size) {
+    int16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void int16_sse_less(int16 *input, int16 *value, uint64_t *bitmap, uint64_t size) {
+    int16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void int16_sse_greater(int16 *input, int16 *value, uint64_t *bitmap, uint64_t size) {
+    int16 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void int16_sse_between(int16 *input, int16 *bounds, uint64_t *bitmap, uint64_t size) {
+    int16 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void int16_sse_compare_equal(int16 *input1, int16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void int16_sse_compare_less(int16 *input1, int16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void int16_sse_compare_less_equal(int16 *input1, int16 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void int16_sse_sum_masked(int16 *input, uint64_t *mask, int16 *result, uint64_t size) {
+    int16 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void int16_sse_min_masked(int16 *input, uint64_t *mask, int16 *result, uint64_t size) {
+    int16 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int16 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int16 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int16_sse_max_masked(int16 *input, uint64_t *mask, int16 *result, uint64_t size) {
+    int16 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int16 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int16 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int16_sse_blend(int16 *input1, int16 *input2, uint64_t *mask, int16 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int16);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int16)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void int16_sse_blend_scalar(int16 *input, int16 *value, uint64_t *mask, int16 *output, uint64_t size) {
+    typedef int16 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int16);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int16)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Int32 ----------------------------------
+
+extern "C" void int32_sse_sum(int32 *input, int32 *result, uint64_t size) {
+    int32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void int32_sse_sum_wide(int32 *input, int64 *result, uint64_t size) {
+    int64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += (int64)input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int32_sse_min(int32 *input, int32 *result, uint64_t size) {
+    int32 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int32_sse_max(int32 *input, int32 *result, uint64_t size) {
+    int32 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int32_sse_minmax(int32 *input, int32 *min, int32 *max, uint64_t size) {
+    int32 lo = input[0];
+    int32 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void int32_sse_argmin(int32 *input, uint64_t *result, uint64_t size) {
+    int32 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int32 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int32_sse_argmax(int32 *input, uint64_t *result, uint64_t size) {
+    int32 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int32 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int32_sse_dot(int32 *input1, int32 *input2, int32 *result, uint64_t size) {
+    int32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int32_sse_add(int32 *input1, int32 *input2, int32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void int32_sse_sub(int32 *input1, int32 *input2, int32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void int32_sse_mul(int32 *input1, int32 *input2, int32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void int32_sse_div(int32 *input1, int32 *input2, int32 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input2[i] == -1 ? (unsigned_int32)0 - input1[i] : input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void int32_sse_add_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void int32_sse_sub_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void int32_sse_rsub_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void int32_sse_mul_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void int32_sse_div_scalar(int32 *input, unsigned_int32 *magic, int32 *output, uint64_t size) {
+    const unsigned_int32 m = magic[0], s = magic[1];
+    const int32 sign = magic[2];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 neg = input[i] >> (sizeof(int32) * 8 - 1);
+        unsigned_int32 n = ((unsigned_int32)input[i] ^ neg) - neg;
+        unsigned_int32 q = mulhi(n, m);
+        unsigned_int32 t = (((unsigned_int32)(n - q) >> 1) + q) >> s;
+        output[i] = (t ^ (neg ^ sign)) - (neg ^ sign);
+    }
+}
+
+extern "C" void int32_sse_rdiv_scalar(int32 *input, int32 *value, int32 *output, uint64_t *index, uint64_t size) {
+    int32 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = input[i] == -1 ? (unsigned_int32)0 - k : k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void int32_sse_min_of(int32 *input1, int32 *input2, int32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void int32_sse_max_of(int32 *input1, int32 *input2, int32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void int32_sse_min_of_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void int32_sse_max_of_scalar(int32 *input, int32 *value, int32 *output, uint64_t size) {
+    int32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void int32_sse_clamp(int32 *input, int32 *bounds, int32 *output, uint64_t size) {
+    int32 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int32 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void int32_sse_equals(int32 *input, int32 *value, uint64_t *bitmap, uint64_t size) {
+    int32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void int32_sse_less(int32 *input, int32 *value, uint64_t *bitmap, uint64_t size) {
+    int32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void int32_sse_greater(int32 *input, int32 *value, uint64_t *bitmap, uint64_t size) {
+    int32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void int32_sse_between(int32 *input, int32 *bounds, uint64_t *bitmap, uint64_t size) {
+    int32 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void int32_sse_compare_equal(int32 *input1, int32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void int32_sse_compare_less(int32 *input1, int32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void int32_sse_compare_less_equal(int32 *input1, int32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void int32_sse_sum_masked(int32 *input, uint64_t *mask, int32 *result, uint64_t size) {
+    int32 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void int32_sse_min_masked(int32 *input, uint64_t *mask, int32 *result, uint64_t size) {
+    int32 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int32 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int32 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int32_sse_max_masked(int32 *input, uint64_t *mask, int32 *result, uint64_t size) {
+    int32 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int32 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int32 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int32_sse_compress(int32 *input, uint64_t *mask, int32 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void int32_sse_blend(int32 *input1, int32 *input2, uint64_t *mask, int32 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int32)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void int32_sse_blend_scalar(int32 *input, int32 *value, uint64_t *mask, int32 *output, uint64_t size) {
+    typedef int32 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int32)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Int64 ----------------------------------
+
+extern "C" void int64_sse_sum(int64 *input, int64 *result, uint64_t size) {
+    int64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+
+extern "C" void int64_sse_min(int64 *input, int64 *result, uint64_t size) {
+    int64 min = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < min) {
+            min = input[i];
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int64_sse_max(int64 *input, int64 *result, uint64_t size) {
+    int64 max = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] > max) {
+            max = input[i];
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int64_sse_minmax(int64 *input, int64 *min, int64 *max, uint64_t size) {
+    int64 lo = input[0];
+    int64 hi = input[0];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        if (input[i] < lo) {
+            lo = input[i];
+        }
+        if (input[i] > hi) {
+            hi = input[i];
+        }
+    }
+    *min = lo;
+    *max = hi;
+}
+
+extern "C" void int64_sse_argmin(int64 *input, uint64_t *result, uint64_t size) {
+    int64 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int64 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int64_sse_argmax(int64 *input, uint64_t *result, uint64_t size) {
+    int64 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        int64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        int64 value = chunk[0];
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void int64_sse_dot(int64 *input1, int64 *input2, int64 *result, uint64_t size) {
+    int64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void int64_sse_add(int64 *input1, int64 *input2, int64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void int64_sse_sub(int64 *input1, int64 *input2, int64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void int64_sse_mul(int64 *input1, int64 *input2, int64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+extern "C" void int64_sse_div(int64 *input1, int64 *input2, int64 *output, uint64_t *index, uint64_t size) {
+    uint64_t i = 0;
+    for (; i < size && input2[i] != 0; i++) {
+        output[i] = input2[i] == -1 ? (unsigned_int64)0 - input1[i] : input1[i] / input2[i];
+    }
+    *index = i;
+}
+
+extern "C" void int64_sse_add_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void int64_sse_sub_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void int64_sse_rsub_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void int64_sse_mul_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+extern "C" void int64_sse_div_scalar(int64 *input, unsigned_int64 *magic, int64 *output, uint64_t size) {
+    const unsigned_int64 m = magic[0], s = magic[1];
+    const int64 sign = magic[2];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 neg = input[i] >> (sizeof(int64) * 8 - 1);
+        unsigned_int64 n = ((unsigned_int64)input[i] ^ neg) - neg;
+        unsigned_int64 q = mulhi(n, m);
+        unsigned_int64 t = (((unsigned_int64)(n - q) >> 1) + q) >> s;
+        output[i] = (t ^ (neg ^ sign)) - (neg ^ sign);
+    }
+}
+
+extern "C" void int64_sse_rdiv_scalar(int64 *input, int64 *value, int64 *output, uint64_t *index, uint64_t size) {
+    int64 k = *value;
+    uint64_t i = 0;
+    for (; i < size && input[i] != 0; i++) {
+        output[i] = input[i] == -1 ? (unsigned_int64)0 - k : k / input[i];
+    }
+    *index = i;
+}
+
+extern "C" void int64_sse_min_of(int64 *input1, int64 *input2, int64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 a = input1[i], b = input2[i];
+        output[i] = a < b ? a : b;
+    }
+}
+
+extern "C" void int64_sse_max_of(int64 *input1, int64 *input2, int64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 a = input1[i], b = input2[i];
+        output[i] = a > b ? a : b;
+    }
+}
+
+extern "C" void int64_sse_min_of_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 a = input[i];
+        output[i] = a < k ? a : k;
+    }
+}
+
+extern "C" void int64_sse_max_of_scalar(int64 *input, int64 *value, int64 *output, uint64_t size) {
+    int64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 a = input[i];
+        output[i] = a > k ? a : k;
+    }
+}
+
+extern "C" void int64_sse_clamp(int64 *input, int64 *bounds, int64 *output, uint64_t size) {
+    int64 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        int64 a = input[i];
+        a = a > lo ? a : lo;
+        output[i] = a < hi ? a : hi;
+    }
+}
+
+extern "C" void int64_sse_equals(int64 *input, int64 *value, uint64_t *bitmap, uint64_t size) {
+    int64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] == k);
+    }
+}
+
+extern "C" void int64_sse_less(int64 *input, int64 *value, uint64_t *bitmap, uint64_t size) {
+    int64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] < k);
+    }
+}
+
+extern "C" void int64_sse_greater(int64 *input, int64 *value, uint64_t *bitmap, uint64_t size) {
+    int64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input[i + j] > k);
+    }
+}
+
+extern "C" void int64_sse_between(int64 *input, int64 *bounds, uint64_t *bitmap, uint64_t size) {
+    int64 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void int64_sse_compare_equal(int64 *input1, int64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void int64_sse_compare_less(int64 *input1, int64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void int64_sse_compare_less_equal(int64 *input1, int64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BITS(input1[i + j] <= input2[i + j]);
+    }
+}
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void int64_sse_sum_masked(int64 *input, uint64_t *mask, int64 *result, uint64_t size) {
+    int64 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void int64_sse_min_masked(int64 *input, uint64_t *mask, int64 *result, uint64_t size) {
+    int64 min = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int64 value = input[i + j];
+                if (value < min) {
+                    min = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int64 value = input[i + __builtin_ctzll(bits)];
+            if (value < min) {
+                min = value;
+            }
+        }
+    }
+    *result = min;
+}
+
+extern "C" void int64_sse_max_masked(int64 *input, uint64_t *mask, int64 *result, uint64_t size) {
+    int64 max = *result;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                int64 value = input[i + j];
+                if (value > max) {
+                    max = value;
+                }
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            int64 value = input[i + __builtin_ctzll(bits)];
+            if (value > max) {
+                max = value;
+            }
+        }
+    }
+    *result = max;
+}
+
+extern "C" void int64_sse_compress(int64 *input, uint64_t *mask, int64 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void int64_sse_blend(int64 *input1, int64 *input2, uint64_t *mask, int64 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int64)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void int64_sse_blend_scalar(int64 *input, int64 *value, uint64_t *mask, int64 *output, uint64_t size) {
+    typedef int64 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(int64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(int64)>(bits >> k)));
+        }
+    }
+}
+
+// ---------------------------------- Float32 ----------------------------------
+
+extern "C" void float32_sse_sum(float32 *input, float32 *result, uint64_t size) {
+    float32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float32_sse_sum_kahan(float32 *input, float32 *sum, float32 *err, uint64_t size) {
+    const int width = VECTOR / sizeof(float32), count = ROW / VECTOR;
+    vector_float32 s[count] = {}, e[count] = {};
+    for (uint64_t i = 0; i < size; i += width * count) {
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            vector_float32 x = *(vector_float32 *)(input + i + k * width);
+            vector_float32 y = x - e[k];
+            vector_float32 t = s[k] + y;
+            e[k] = (t - s[k]) - y;
+            s[k] = t;
+        }
+    }
+    #pragma clang loop unroll(full)
+    for (int k = 0; k < count; k++) {
+        *(vector_float32 *)(sum + k * width) = s[k];
+        *(vector_float32 *)(err + k * width) = e[k];
+    }
+}
+
+extern "C" void float32_sse_sum_pairwise(float32 *input, float32 *stack, uint64_t size) {
+    const int width = VECTOR / sizeof(float32), count = ROW / VECTOR;
+    const uint64_t block = BLOCK * width * count;
+    int depth = 0;
+    for (uint64_t offset = 0, n = 0; offset < size; offset += block, n++) {
+        uint64_t end = size - offset < block ? size : offset + block;
+        vector_float32 s[count] = {};
+        for (uint64_t i = offset; i < end; i += width * count) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < count; k++) {
+                vector_float32 x = *(vector_float32 *)(input + i + k * width);
+                s[k] += x;
+            }
+        }
+        for (uint64_t carry = n; carry & 1; carry >>= 1) {
+            depth--;
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < count; k++) {
+                vector_float32 x = *(vector_float32 *)(stack + (depth * count + k) * width);
+                s[k] = x + s[k];
+            }
+        }
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            *(vector_float32 *)(stack + (depth * count + k) * width) = s[k];
+        }
+        depth++;
+    }
+
+    vector_float32 total[count] = {};
+    while (depth > 0) {
+        depth--;
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            vector_float32 x = *(vector_float32 *)(stack + (depth * count + k) * width);
+            total[k] = x + total[k];
+        }
+    }
+    #pragma clang loop unroll(full)
+    for (int k = 0; k < count; k++) {
+        *(vector_float32 *)(stack + k * width) = total[k];
+    }
+}
+
+#pragma float_control(pop)
+
+
+extern "C" void float32_sse_sum_wide(float32 *input, float64 *result, uint64_t size) {
+    float64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += (float64)input[i];
+    }
+    *result = sum;
+}
+
+extern "C" void float32_sse_min(float32 *input, float32 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float32) / sizeof(float32);
+    float32 min = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float32 m[4] = {load(input), load(input + width), load(input + 2 * width), load(input + 3 * width)};
+        nans_float32 n = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            lanes_float32 x[4];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                x[k] = load(input + i + k * width);
+                m[k] = lower(m[k], x[k]);
+            }
+            n = either(n, either(unordered(x[0], x[1]), unordered(x[2], x[3])));
+        }
+        min = lower(min, lower(lower(m[0], m[1]), lower(m[2], m[3])));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        min = lower(min, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *result = min;
+    if (nan) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float32>(input, 0, size, true));
+    }
+}
+
+extern "C" void float32_sse_max(float32 *input, float32 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float32) / sizeof(float32);
+    float32 max = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float32 m[4] = {load(input), load(input + width), load(input + 2 * width), load(input + 3 * width)};
+        nans_float32 n = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            lanes_float32 x[4];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                x[k] = load(input + i + k * width);
+                m[k] = upper(m[k], x[k]);
+            }
+            n = either(n, either(unordered(x[0], x[1]), unordered(x[2], x[3])));
+        }
+        max = upper(max, upper(upper(m[0], m[1]), upper(m[2], m[3])));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        max = upper(max, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *result = max;
+    if (nan) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float32>(input, 0, size, false));
+    }
+}
+
+extern "C" void float32_sse_nanmin(float32 *input, float32 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float32) / sizeof(float32);
+    float32 inf = infinity(result);
+    float32 min = inf;
+    bool valid = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float32 m[4] = {splat(inf), splat(inf), splat(inf), splat(inf)};
+        nans_float32 v = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                lanes_float32 x = load(input + i + k * width);
+                m[k] = lower(m[k], replace(x, unordered(x, x), splat(inf)));
+                v = either(v, ordered(x));
+            }
+        }
+        min = lower(min, lower(lower(m[0], m[1]), lower(m[2], m[3])));
+        valid = any(v);
+    }
+    for (; i < size; i++) {
+        if (!is_nan(input[i])) {
+            min = lower(min, input[i]);
+            valid = true;
+        }
+    }
+    *result = min;
+    if (!valid) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float32>(input, 0, size, true));
+    }
+}
+
+extern "C" void float32_sse_nanmax(float32 *input, float32 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float32) / sizeof(float32);
+    float32 inf = -infinity(result);
+    float32 max = inf;
+    bool valid = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float32 m[4] = {splat(inf), splat(inf), splat(inf), splat(inf)};
+        nans_float32 v = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                lanes_float32 x = load(input + i + k * width);
+                m[k] = upper(m[k], replace(x, unordered(x, x), splat(inf)));
+                v = either(v, ordered(x));
+            }
+        }
+        max = upper(max, upper(upper(m[0], m[1]), upper(m[2], m[3])));
+        valid = any(v);
+    }
+    for (; i < size; i++) {
+        if (!is_nan(input[i])) {
+            max = upper(max, input[i]);
+            valid = true;
+        }
+    }
+    *result = max;
+    if (!valid) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float32>(input, 0, size, false));
+    }
+}
+
+extern "C" void float32_sse_minmax(float32 *input, float32 *min, float32 *max, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float32) / sizeof(float32);
+    float32 lo = input[0];
+    float32 hi = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 2 * width) {
+        lanes_float32 l[2] = {load(input), load(input + width)};
+        lanes_float32 h[2] = {l[0], l[1]};
+        nans_float32 n = {};
+        for (; i + 2 * width <= size; i += 2 * width) {
+            lanes_float32 x[2];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 2; k++) {
+                x[k] = load(input + i + k * width);
+                l[k] = lower(l[k], x[k]);
+                h[k] = upper(h[k], x[k]);
+            }
+            n = either(n, unordered(x[0], x[1]));
+        }
+        lo = lower(lo, lower(l[0], l[1]));
+        hi = upper(hi, upper(h[0], h[1]));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        lo = lower(lo, input[i]);
+        hi = upper(hi, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *min = lo;
+    *max = hi;
+    if (nan) {
+        set_nan(min);
+        set_nan(max);
+        return;
+    }
+    if (is_zero(lo)) {
+        set_zero(min, has_zero<mask_float32>(input, 0, size, true));
+    }
+    if (is_zero(hi)) {
+        set_zero(max, !has_zero<mask_float32>(input, 0, size, false));
+    }
+}
+
+extern "C" void float32_sse_argmin(float32 *input, uint64_t *result, uint64_t size) {
+    float32 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        float32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        float32 value = chunk[0];
+        mask_float32 nan = 0;
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+            nan |= is_nan(chunk[i]);
+        }
+        if (nan) {
+            for (int i = 0; i < count; i++) {
+                if (is_nan(chunk[i])) {
+                    *result = offset + i;
+                    return;
+                }
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void float32_sse_argmax(float32 *input, uint64_t *result, uint64_t size) {
+    float32 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        float32 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        float32 value = chunk[0];
+        mask_float32 nan = 0;
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+            nan |= is_nan(chunk[i]);
+        }
+        if (nan) {
+            for (int i = 0; i < count; i++) {
+                if (is_nan(chunk[i])) {
+                    *result = offset + i;
+                    return;
+                }
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void float32_sse_dot(float32 *input1, float32 *input2, float32 *result, uint64_t size) {
+    float32 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void float32_sse_add(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void float32_sse_sub(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void float32_sse_mul(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+// Float division is exact, rather than a multiplication by an approximate reciprocal, so that every instruction
+// set returns the same quotient as Go does.
+#pragma float_control(precise, on, push)
+
+extern "C" void float32_sse_div(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+}
+
+#pragma float_control(pop)
+
+extern "C" void float32_sse_add_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void float32_sse_sub_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void float32_sse_rsub_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void float32_sse_mul_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float32_sse_div_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] / k;
+    }
+}
+
+extern "C" void float32_sse_rdiv_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k / input[i];
+    }
+}
+
+#pragma float_control(pop)
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float32_sse_min_of(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float32 a = input1[i], b = input2[i];
+        output[i] = a < b || a != a ? a : b;
+    }
+}
+
+extern "C" void float32_sse_max_of(float32 *input1, float32 *input2, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float32 a = input1[i], b = input2[i];
+        output[i] = a > b || a != a ? a : b;
+    }
+}
+
+extern "C" void float32_sse_min_of_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float32 a = input[i];
+        output[i] = a < k || a != a ? a : k;
+    }
+}
+
+extern "C" void float32_sse_max_of_scalar(float32 *input, float32 *value, float32 *output, uint64_t size) {
+    float32 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float32 a = input[i];
+        output[i] = a > k || a != a ? a : k;
+    }
+}
+
+extern "C" void float32_sse_clamp(float32 *input, float32 *bounds, float32 *output, uint64_t size) {
+    float32 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float32 a = input[i];
+        a = a > lo || a != a ? a : lo;
+        output[i] = a < hi || a != a ? a : hi;
+    }
+}
+
+extern "C" void float32_sse_equals(float32 *input, float32 *value, uint64_t *bitmap, uint64_t size) {
+    float32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void float32_sse_less(float32 *input, float32 *value, uint64_t *bitmap, uint64_t size) {
+    float32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void float32_sse_greater(float32 *input, float32 *value, uint64_t *bitmap, uint64_t size) {
+    float32 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void float32_sse_between(float32 *input, float32 *bounds, uint64_t *bitmap, uint64_t size) {
+    float32 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void float32_sse_compare_equal(float32 *input1, float32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void float32_sse_compare_less(float32 *input1, float32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void float32_sse_compare_less_equal(float32 *input1, float32 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+#pragma float_control(pop)
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void float32_sse_sum_masked(float32 *input, uint64_t *mask, float32 *result, uint64_t size) {
+    float32 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void float32_sse_min_masked(float32 *input, uint64_t *mask, float32 *result, uint64_t size) {
+    float32 min = *result;
+    lanes_float32 m = splat(min);
+    nans_float32 n = {};
+    bool nan = false;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop unroll(full)
+            for (uint64_t j = 0; j < 64; j += 2 * sizeof(m) / sizeof(float32)) {
+                lanes_float32 x = load(input + i + j), y = load(input + i + j + sizeof(m) / sizeof(float32));
+                m = lower(lower(m, x), y);
+                n = either(n, unordered(x, y));
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            float32 value = input[i + __builtin_ctzll(bits)];
+            min = lower(min, value);
+            nan |= is_nan(value);
+        }
+    }
+    min = lower(min, m);
+    *result = min;
+    if (nan || any(n)) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float32>(input, mask, size, true));
+    }
+}
+
+extern "C" void float32_sse_max_masked(float32 *input, uint64_t *mask, float32 *result, uint64_t size) {
+    float32 max = *result;
+    lanes_float32 m = splat(max);
+    nans_float32 n = {};
+    bool nan = false;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop unroll(full)
+            for (uint64_t j = 0; j < 64; j += 2 * sizeof(m) / sizeof(float32)) {
+                lanes_float32 x = load(input + i + j), y = load(input + i + j + sizeof(m) / sizeof(float32));
+                m = upper(upper(m, x), y);
+                n = either(n, unordered(x, y));
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            float32 value = input[i + __builtin_ctzll(bits)];
+            max = upper(max, value);
+            nan |= is_nan(value);
+        }
+    }
+    max = upper(max, m);
+    *result = max;
+    if (nan || any(n)) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float32>(input, mask, size, false));
+    }
+}
+
+extern "C" void float32_sse_compress(float32 *input, uint64_t *mask, float32 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void float32_sse_blend(float32 *input1, float32 *input2, uint64_t *mask, float32 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(float32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(float32)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void float32_sse_blend_scalar(float32 *input, float32 *value, uint64_t *mask, float32 *output, uint64_t size) {
+    typedef float32 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(float32);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(float32)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void float32_sse_fma(float32 *input1, float32 *input2, float32 *input3, float32 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i] + input3[i];
+    }
+}
+
+extern "C" void float32_sse_axpy(float32 *alpha, float32 *x, float32 *y, float32 *output, uint64_t size) {
+    float32 a = *alpha;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = a * x[i] + y[i];
+    }
+}
+
+// ---------------------------------- Float64 ----------------------------------
+
+extern "C" void float64_sse_sum(float64 *input, float64 *result, uint64_t size) {
+    float64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; ++i) {
+        sum += input[i];
+    }
+    *result = sum;
+}
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float64_sse_sum_kahan(float64 *input, float64 *sum, float64 *err, uint64_t size) {
+    const int width = VECTOR / sizeof(float64), count = ROW / VECTOR;
+    vector_float64 s[count] = {}, e[count] = {};
+    for (uint64_t i = 0; i < size; i += width * count) {
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            vector_float64 x = *(vector_float64 *)(input + i + k * width);
+            vector_float64 y = x - e[k];
+            vector_float64 t = s[k] + y;
+            e[k] = (t - s[k]) - y;
+            s[k] = t;
+        }
+    }
+    #pragma clang loop unroll(full)
+    for (int k = 0; k < count; k++) {
+        *(vector_float64 *)(sum + k * width) = s[k];
+        *(vector_float64 *)(err + k * width) = e[k];
+    }
+}
+
+extern "C" void float64_sse_sum_pairwise(float64 *input, float64 *stack, uint64_t size) {
+    const int width = VECTOR / sizeof(float64), count = ROW / VECTOR;
+    const uint64_t block = BLOCK * width * count;
+    int depth = 0;
+    for (uint64_t offset = 0, n = 0; offset < size; offset += block, n++) {
+        uint64_t end = size - offset < block ? size : offset + block;
+        vector_float64 s[count] = {};
+        for (uint64_t i = offset; i < end; i += width * count) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < count; k++) {
+                vector_float64 x = *(vector_float64 *)(input + i + k * width);
+                s[k] += x;
+            }
+        }
+        for (uint64_t carry = n; carry & 1; carry >>= 1) {
+            depth--;
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < count; k++) {
+                vector_float64 x = *(vector_float64 *)(stack + (depth * count + k) * width);
+                s[k] = x + s[k];
+            }
+        }
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            *(vector_float64 *)(stack + (depth * count + k) * width) = s[k];
+        }
+        depth++;
+    }
+
+    vector_float64 total[count] = {};
+    while (depth > 0) {
+        depth--;
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < count; k++) {
+            vector_float64 x = *(vector_float64 *)(stack + (depth * count + k) * width);
+            total[k] = x + total[k];
+        }
+    }
+    #pragma clang loop unroll(full)
+    for (int k = 0; k < count; k++) {
+        *(vector_float64 *)(stack + k * width) = total[k];
+    }
+}
+
+#pragma float_control(pop)
+
+
+extern "C" void float64_sse_min(float64 *input, float64 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float64) / sizeof(float64);
+    float64 min = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float64 m[4] = {load(input), load(input + width), load(input + 2 * width), load(input + 3 * width)};
+        nans_float64 n = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            lanes_float64 x[4];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                x[k] = load(input + i + k * width);
+                m[k] = lower(m[k], x[k]);
+            }
+            n = either(n, either(unordered(x[0], x[1]), unordered(x[2], x[3])));
+        }
+        min = lower(min, lower(lower(m[0], m[1]), lower(m[2], m[3])));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        min = lower(min, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *result = min;
+    if (nan) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float64>(input, 0, size, true));
+    }
+}
+
+extern "C" void float64_sse_max(float64 *input, float64 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float64) / sizeof(float64);
+    float64 max = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float64 m[4] = {load(input), load(input + width), load(input + 2 * width), load(input + 3 * width)};
+        nans_float64 n = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            lanes_float64 x[4];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                x[k] = load(input + i + k * width);
+                m[k] = upper(m[k], x[k]);
+            }
+            n = either(n, either(unordered(x[0], x[1]), unordered(x[2], x[3])));
+        }
+        max = upper(max, upper(upper(m[0], m[1]), upper(m[2], m[3])));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        max = upper(max, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *result = max;
+    if (nan) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float64>(input, 0, size, false));
+    }
+}
+
+extern "C" void float64_sse_nanmin(float64 *input, float64 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float64) / sizeof(float64);
+    float64 inf = infinity(result);
+    float64 min = inf;
+    bool valid = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float64 m[4] = {splat(inf), splat(inf), splat(inf), splat(inf)};
+        nans_float64 v = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                lanes_float64 x = load(input + i + k * width);
+                m[k] = lower(m[k], replace(x, unordered(x, x), splat(inf)));
+                v = either(v, ordered(x));
+            }
+        }
+        min = lower(min, lower(lower(m[0], m[1]), lower(m[2], m[3])));
+        valid = any(v);
+    }
+    for (; i < size; i++) {
+        if (!is_nan(input[i])) {
+            min = lower(min, input[i]);
+            valid = true;
+        }
+    }
+    *result = min;
+    if (!valid) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float64>(input, 0, size, true));
+    }
+}
+
+extern "C" void float64_sse_nanmax(float64 *input, float64 *result, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float64) / sizeof(float64);
+    float64 inf = -infinity(result);
+    float64 max = inf;
+    bool valid = false;
+    uint64_t i = 0;
+    if (size >= 4 * width) {
+        lanes_float64 m[4] = {splat(inf), splat(inf), splat(inf), splat(inf)};
+        nans_float64 v = {};
+        for (; i + 4 * width <= size; i += 4 * width) {
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 4; k++) {
+                lanes_float64 x = load(input + i + k * width);
+                m[k] = upper(m[k], replace(x, unordered(x, x), splat(inf)));
+                v = either(v, ordered(x));
+            }
+        }
+        max = upper(max, upper(upper(m[0], m[1]), upper(m[2], m[3])));
+        valid = any(v);
+    }
+    for (; i < size; i++) {
+        if (!is_nan(input[i])) {
+            max = upper(max, input[i]);
+            valid = true;
+        }
+    }
+    *result = max;
+    if (!valid) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float64>(input, 0, size, false));
+    }
+}
+
+extern "C" void float64_sse_minmax(float64 *input, float64 *min, float64 *max, uint64_t size) {
+    const uint64_t width = sizeof(lanes_float64) / sizeof(float64);
+    float64 lo = input[0];
+    float64 hi = input[0];
+    bool nan = false;
+    uint64_t i = 0;
+    if (size >= 2 * width) {
+        lanes_float64 l[2] = {load(input), load(input + width)};
+        lanes_float64 h[2] = {l[0], l[1]};
+        nans_float64 n = {};
+        for (; i + 2 * width <= size; i += 2 * width) {
+            lanes_float64 x[2];
+            #pragma clang loop unroll(full)
+            for (int k = 0; k < 2; k++) {
+                x[k] = load(input + i + k * width);
+                l[k] = lower(l[k], x[k]);
+                h[k] = upper(h[k], x[k]);
+            }
+            n = either(n, unordered(x[0], x[1]));
+        }
+        lo = lower(lo, lower(l[0], l[1]));
+        hi = upper(hi, upper(h[0], h[1]));
+        nan = any(n);
+    }
+    for (; i < size; i++) {
+        lo = lower(lo, input[i]);
+        hi = upper(hi, input[i]);
+        nan |= is_nan(input[i]);
+    }
+    *min = lo;
+    *max = hi;
+    if (nan) {
+        set_nan(min);
+        set_nan(max);
+        return;
+    }
+    if (is_zero(lo)) {
+        set_zero(min, has_zero<mask_float64>(input, 0, size, true));
+    }
+    if (is_zero(hi)) {
+        set_zero(max, !has_zero<mask_float64>(input, 0, size, false));
+    }
+}
+
+extern "C" void float64_sse_argmin(float64 *input, uint64_t *result, uint64_t size) {
+    float64 min = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        float64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        float64 value = chunk[0];
+        mask_float64 nan = 0;
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] < value) {
+                value = chunk[i];
+            }
+            nan |= is_nan(chunk[i]);
+        }
+        if (nan) {
+            for (int i = 0; i < count; i++) {
+                if (is_nan(chunk[i])) {
+                    *result = offset + i;
+                    return;
+                }
+            }
+        }
+        if (value < min) {
+            min = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == min) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void float64_sse_argmax(float64 *input, uint64_t *result, uint64_t size) {
+    float64 max = input[0];
+    uint64_t block = 0;
+    for (uint64_t offset = 0; offset < size; offset += 256) {
+        float64 *chunk = input + offset;
+        int count = (int)(size - offset < 256 ? size - offset : 256);
+        float64 value = chunk[0];
+        mask_float64 nan = 0;
+        #pragma clang loop vectorize(enable) interleave(enable)
+        for (int i = 0; i < count; i++) {
+            if (chunk[i] > value) {
+                value = chunk[i];
+            }
+            nan |= is_nan(chunk[i]);
+        }
+        if (nan) {
+            for (int i = 0; i < count; i++) {
+                if (is_nan(chunk[i])) {
+                    *result = offset + i;
+                    return;
+                }
+            }
+        }
+        if (value > max) {
+            max = value;
+            block = offset;
+        }
+    }
+    for (uint64_t i = block; i < size; i++) {
+        if (input[i] == max) {
+            *result = i;
+            return;
+        }
+    }
+    *result = block;
+}
+
+extern "C" void float64_sse_dot(float64 *input1, float64 *input2, float64 *result, uint64_t size) {
+    float64 sum = 0.0;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        sum += input1[i] * input2[i];
+    }
+    *result = sum;
+}
+
+extern "C" void float64_sse_add(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] + input2[i];
+    }
+}
+
+extern "C" void float64_sse_sub(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] - input2[i];
+    }
+}
+
+extern "C" void float64_sse_mul(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i];
+    }
+}
+
+
+// Float division is exact, rather than a multiplication by an approximate reciprocal, so that every instruction
+// set returns the same quotient as Go does.
+#pragma float_control(precise, on, push)
+
+extern "C" void float64_sse_div(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] / input2[i];
+    }
+}
+
+#pragma float_control(pop)
+
+extern "C" void float64_sse_add_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] + k;
+    }
+}
+
+extern "C" void float64_sse_sub_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] - k;
+    }
+}
+
+extern "C" void float64_sse_rsub_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k - input[i];
+    }
+}
+
+extern "C" void float64_sse_mul_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] * k;
+    }
+}
+
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float64_sse_div_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input[i] / k;
+    }
+}
+
+extern "C" void float64_sse_rdiv_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = k / input[i];
+    }
+}
+
+#pragma float_control(pop)
+
+#pragma float_control(precise, on, push)
+
+extern "C" void float64_sse_min_of(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float64 a = input1[i], b = input2[i];
+        output[i] = a < b || a != a ? a : b;
+    }
+}
+
+extern "C" void float64_sse_max_of(float64 *input1, float64 *input2, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float64 a = input1[i], b = input2[i];
+        output[i] = a > b || a != a ? a : b;
+    }
+}
+
+extern "C" void float64_sse_min_of_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float64 a = input[i];
+        output[i] = a < k || a != a ? a : k;
+    }
+}
+
+extern "C" void float64_sse_max_of_scalar(float64 *input, float64 *value, float64 *output, uint64_t size) {
+    float64 k = *value;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float64 a = input[i];
+        output[i] = a > k || a != a ? a : k;
+    }
+}
+
+extern "C" void float64_sse_clamp(float64 *input, float64 *bounds, float64 *output, uint64_t size) {
+    float64 lo = bounds[0], hi = bounds[1];
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        float64 a = input[i];
+        a = a > lo || a != a ? a : lo;
+        output[i] = a < hi || a != a ? a : hi;
+    }
+}
+
+extern "C" void float64_sse_equals(float64 *input, float64 *value, uint64_t *bitmap, uint64_t size) {
+    float64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] == k);
+    }
+}
+
+extern "C" void float64_sse_less(float64 *input, float64 *value, uint64_t *bitmap, uint64_t size) {
+    float64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] < k);
+    }
+}
+
+extern "C" void float64_sse_greater(float64 *input, float64 *value, uint64_t *bitmap, uint64_t size) {
+    float64 k = *value;
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input[i + j] > k);
+    }
+}
+
+extern "C" void float64_sse_between(float64 *input, float64 *bounds, uint64_t *bitmap, uint64_t size) {
+    float64 lo = bounds[0], hi = bounds[1];
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES((input[i + j] >= lo) & (input[i + j] <= hi));
+    }
+}
+
+extern "C" void float64_sse_compare_equal(float64 *input1, float64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] == input2[i + j]);
+    }
+}
+
+extern "C" void float64_sse_compare_less(float64 *input1, float64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] < input2[i + j]);
+    }
+}
+
+extern "C" void float64_sse_compare_less_equal(float64 *input1, float64 *input2, uint64_t *bitmap, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        COMPARE_BYTES(input1[i + j] <= input2[i + j]);
+    }
+}
+
+#pragma float_control(pop)
+
+// Masked reductions only take the elements whose bit is set in the mask, with one word for every 64 elements.
+// Words with every bit set are reduced as a whole, which vectorizes, while the others only visit their set bits.
+extern "C" void float64_sse_sum_masked(float64 *input, uint64_t *mask, float64 *result, uint64_t size) {
+    float64 sum = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                sum += input[i + j];
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            sum += input[i + __builtin_ctzll(bits)];
+        }
+    }
+    *result = sum;
+}
+
+extern "C" void float64_sse_min_masked(float64 *input, uint64_t *mask, float64 *result, uint64_t size) {
+    float64 min = *result;
+    lanes_float64 m = splat(min);
+    nans_float64 n = {};
+    bool nan = false;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop unroll(full)
+            for (uint64_t j = 0; j < 64; j += 2 * sizeof(m) / sizeof(float64)) {
+                lanes_float64 x = load(input + i + j), y = load(input + i + j + sizeof(m) / sizeof(float64));
+                m = lower(lower(m, x), y);
+                n = either(n, unordered(x, y));
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            float64 value = input[i + __builtin_ctzll(bits)];
+            min = lower(min, value);
+            nan |= is_nan(value);
+        }
+    }
+    min = lower(min, m);
+    *result = min;
+    if (nan || any(n)) {
+        set_nan(result);
+    } else if (is_zero(min)) {
+        set_zero(result, has_zero<mask_float64>(input, mask, size, true));
+    }
+}
+
+extern "C" void float64_sse_max_masked(float64 *input, uint64_t *mask, float64 *result, uint64_t size) {
+    float64 max = *result;
+    lanes_float64 m = splat(max);
+    nans_float64 n = {};
+    bool nan = false;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = size - i < 64 ? mask[i / 64] & ((1ull << (size - i)) - 1) : mask[i / 64];
+        if (bits == ~0ull) {
+            #pragma clang loop unroll(full)
+            for (uint64_t j = 0; j < 64; j += 2 * sizeof(m) / sizeof(float64)) {
+                lanes_float64 x = load(input + i + j), y = load(input + i + j + sizeof(m) / sizeof(float64));
+                m = upper(upper(m, x), y);
+                n = either(n, unordered(x, y));
+            }
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            float64 value = input[i + __builtin_ctzll(bits)];
+            max = upper(max, value);
+            nan |= is_nan(value);
+        }
+    }
+    max = upper(max, m);
+    *result = max;
+    if (nan || any(n)) {
+        set_nan(result);
+    } else if (is_zero(max)) {
+        set_zero(result, !has_zero<mask_float64>(input, mask, size, false));
+    }
+}
+
+extern "C" void float64_sse_compress(float64 *input, uint64_t *mask, float64 *output, uint64_t size) {
+    uint64_t n = 0;
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        if (bits == 0) {
+            continue;
+        }
+        if (bits == ~0ull) {
+            #pragma clang loop vectorize(enable) interleave(enable)
+            for (int j = 0; j < 64; j++) {
+                output[n + j] = input[i + j];
+            }
+            n += 64;
+            continue;
+        }
+        for (; bits != 0; bits &= bits - 1) {
+            output[n++] = input[i + __builtin_ctzll(bits)];
+        }
+    }
+}
+
+extern "C" void float64_sse_blend(float64 *input1, float64 *input2, uint64_t *mask, float64 *output, uint64_t size) {
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(float64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input1 + i + k));
+            __m128i b = _mm_loadu_si128((__m128i *)(input2 + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(float64)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void float64_sse_blend_scalar(float64 *input, float64 *value, uint64_t *mask, float64 *output, uint64_t size) {
+    typedef float64 fill_t __attribute__((vector_size(16)));
+    __m128i b = (__m128i)((fill_t){} + *value);
+    for (uint64_t i = 0; i < size; i += 64) {
+        uint64_t bits = mask[i / 64];
+        const int lanes = 16 / sizeof(float64);
+        #pragma clang loop unroll(full)
+        for (int k = 0; k < 64; k += lanes) {
+            __m128i a = _mm_loadu_si128((__m128i *)(input + i + k));
+            _mm_storeu_si128((__m128i *)(output + i + k), _mm_blendv_epi8(b, a, blend_mask<sizeof(float64)>(bits >> k)));
+        }
+    }
+}
+
+extern "C" void float64_sse_fma(float64 *input1, float64 *input2, float64 *input3, float64 *output, uint64_t size) {
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = input1[i] * input2[i] + input3[i];
+    }
+}
+
+extern "C" void float64_sse_axpy(float64 *alpha, float64 *x, float64 *y, float64 *output, uint64_t size) {
+    float64 a = *alpha;
+    #pragma clang loop vectorize(enable) interleave(enable)
+    for (int i = 0; i < (int)size; i++) {
+        output[i] = a * x[i] + y[i];
+    }
+}
@@ -0,0 +1,137 @@
+// Copyright (c) Roman Atachiants and contributors. All rights reserved.
+// Licensed under the MIT license. See LICENSE file in the project root for details.
+
+package simd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/cpuid/v2"
+)
+
+// Level represents an instruction set used by the vectorized functions
+type Level uint8
+
+// Supported instruction sets, in the order of preference
+const (
+	Generic Level = iota // Portable Go code, without any vectorization
+	SSE                  // SSE4.1 instructions, available on most of x86-64 CPUs
+	AVX2                 // AVX2 instructions
+	AVX512               // AVX-512 instructions (F, BW, DQ and VL)
+)
+
+// String returns the name of the instruction set
+func (l Level) String() string {
+	switch l {
+	case SSE:
+		return "sse"
+	case AVX2:
+		return "avx2"
+	case AVX512:
+		return "avx512"
+	default:
+		return "generic"
+	}
+}
+
+// supported is the best instruction set available on this CPU
+var supported = detect()
+
+// fma3 is true if the CPU supports fused multiply-add, which is only used along with AVX2 or above
+var fma3 = cpuid.CPU.Supports(cpuid.FMA3)
+
+// avx512vbmi2 is true if the CPU can compress bytes and words, which is only used along with AVX-512
+var avx512vbmi2 = cpuid.CPU.Supports(cpuid.AVX512VBMI2)
+
+// active is the instruction set currently used for dispatching
+var active Level
+
+func init() {
+	level, err := disabled(os.Getenv("SIMD_DISABLE"))
+	if err != nil {
+		log.Print(err)
+	}
+
+	SetLevel(level)
+}
+
+// Features returns the instruction set currently used by the vectorized functions
+func Features() Level {
+	return active
+}
+
+// SetLevel changes the instruction set used by the vectorized functions and returns
+// the one actually in effect, which is capped by what the CPU supports. For example,
+// SetLevel(Generic) turns off vectorization altogether. This is meant to be called
+// during start-up as it is not safe to call concurrently with other functions.
+func SetLevel(level Level) Level {
+	if level > supported {
+		level = supported
+	}
+
+	active = level
+	sse = level >= SSE
+	avx2 = level >= AVX2
+	avx512 = level >= AVX512
+	fma = level >= AVX2 && fma3
+	vbmi2 = level >= AVX512 && avx512vbmi2
+	dispatch()
+	return level
+}
+
+// parseLevel returns the instruction set with the given name
+func parseLevel(name string) (Level, bool) {
+	for l := Generic; l <= AVX512; l++ {
+		if strings.EqualFold(name, l.String()) {
+			return l, true
+		}
+	}
+	return Generic, false
+}
+
+// detect returns the best instruction set supported by the CPU
+func detect() Level {
+	switch {
+	case runtime.GOARCH != "amd64":
+		return Generic
+	case cpuid.CPU.Supports(cpuid.AVX512F, cpuid.AVX512BW, cpuid.AVX512DQ, cpuid.AVX512VL):
+		return AVX512
+	case cpuid.CPU.Supports(cpuid.AVX2):
+		return AVX2
+	case cpuid.CPU.Supports(cpuid.SSE4):
+		return SSE
+	default:
+		return Generic
+	}
+}
+
+// disabled returns the instruction set to use for a value of SIMD_DISABLE environment
+// variable. The value is either a boolean to turn off vectorization altogether, or the
+// name of the instruction set to turn off along with all of the ones above it, such as
+// "avx512" to fall back to AVX2. Any other value, including "generic" which can't be
+// turned off, is reported as an error and leaves all of the instruction sets enabled.
+func disabled(value string) (Level, error) {
+	if level, ok := parseLevel(value); ok {
+		if level == Generic {
+			return AVX512, fmt.Errorf("simd: ignoring SIMD_DISABLE=%q, generic code can't be turned off", value)
+		}
+		return level - 1, nil
+	}
+
+	off, err := strconv.ParseBool(value)
+	switch {
+	case value == "":
+		return AVX512, nil
+	case err != nil:
+		return AVX512, fmt.Errorf("simd: ignoring SIMD_DISABLE=%q, expected a boolean or one of sse, avx2 and avx512", value)
+	case off:
+		return Generic, nil
+	default:
+		return AVX512, nil
+	}
+}
@@ -5,42 +5,124 @@ package simd
 
 //go:generate go run ./codegen/main.go
 import (
-	"github.com/klauspost/cpuid/v2"
+	"math"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"unsafe"
 )
 
+// is64 is true if int and uint types are 64-bit wide on the current platform
+const is64 = ^uint(0)>>63 == 1
+
+// Instruction sets used for dispatching, these are set by SetLevel
 var (
-	avx2 = cpuid.CPU.Supports(cpuid.AVX2)
-	sve  = cpuid.CPU.Supports(cpuid.SVE)
+	avx2   bool
+	avx512 bool
+	sse    bool
+	fma    bool
+	vbmi2  bool
 )
 
 // Number represents a number constraint for SIMD operations
 type Number interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 | uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Float represents a floating-point number constraint for SIMD operations
+type Float interface {
+	~float32 | ~float64
+}
+
+// Integer represents an integer number constraint for SIMD operations
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// kindOf returns the kind of the underlying type of T, which allows named numeric
+// types (e.g. type Price float64) to be dispatched to the vectorized functions. The
+// int and uint types map onto the fixed-size kind of the same width. The kind is only
+// derived from arithmetic on T and its size, which the compiler folds into a constant
+// for each instantiation rather than inspecting the type on every call.
+func kindOf[T Number]() reflect.Kind {
+	var zero T
+	one := zero + 1
+	switch {
+	case one/2 != zero:
+		if unsafe.Sizeof(zero) == 4 {
+			return reflect.Float32
+		}
+		return reflect.Float64
+	case zero-one < zero:
+		switch unsafe.Sizeof(zero) {
+		case 1:
+			return reflect.Int8
+		case 2:
+			return reflect.Int16
+		case 4:
+			return reflect.Int32
+		default:
+			return reflect.Int64
+		}
+	default:
+		switch unsafe.Sizeof(zero) {
+		case 1:
+			return reflect.Uint8
+		case 2:
+			return reflect.Uint16
+		case 4:
+			return reflect.Uint32
+		default:
+			return reflect.Uint64
+		}
+	}
+}
+
+// isFloat returns whether the underlying type of T is a floating-point number
+func isFloat[T Number]() bool {
+	kind := kindOf[T]()
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// as reinterprets a slice of T as a slice of U, both must share the same memory layout
+func as[U, T Number](input []T) []U {
+	return *(*[]U)(unsafe.Pointer(&input))
+}
+
+// shortest returns the length of the shortest of the slices
+func shortest[T Number](inputs ...[]T) int {
+	n := len(inputs[0])
+	for _, v := range inputs[1:] {
+		if len(v) < n {
+			n = len(v)
+		}
+	}
+	return n
 }
 
 // Sum sums up all of the elements of the slice and returns the value
 func Sum[T Number](input []T) T {
-	switch v := any(input).(type) {
-	case []int8:
-		return T(SumInt8s(v))
-	case []int16:
-		return T(SumInt16s(v))
-	case []int32:
-		return T(SumInt32s(v))
-	case []int64:
-		return T(SumInt64s(v))
-	case []uint8:
-		return T(SumUint8s(v))
-	case []uint16:
-		return T(SumUint16s(v))
-	case []uint32:
-		return T(SumUint32s(v))
-	case []uint64:
-		return T(SumUint64s(v))
-	case []float32:
-		return T(SumFloat32s(v))
-	case []float64:
-		return T(SumFloat64s(v))
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return T(SumInt8s(as[int8](input)))
+	case reflect.Int16:
+		return T(SumInt16s(as[int16](input)))
+	case reflect.Int32:
+		return T(SumInt32s(as[int32](input)))
+	case reflect.Int64:
+		return T(SumInt64s(as[int64](input)))
+	case reflect.Uint8:
+		return T(SumUint8s(as[uint8](input)))
+	case reflect.Uint16:
+		return T(SumUint16s(as[uint16](input)))
+	case reflect.Uint32:
+		return T(SumUint32s(as[uint32](input)))
+	case reflect.Uint64:
+		return T(SumUint64s(as[uint64](input)))
+	case reflect.Float32:
+		return T(SumFloat32s(as[float32](input)))
+	case reflect.Float64:
+		return T(SumFloat64s(as[float64](input)))
 	default:
 		return sum(input)
 	}
@@ -54,112 +136,1579 @@ func sum[T Number](input []T) (sum T) {
 	return
 }
 
-// Min returns the smallest element value in the slice
+// SumWide sums up all of the elements of the slice into a wider accumulator of type W
+// and returns the value, so that summing small integers does not wrap around. For
+// example, SumWide[uint64](bytes) sums a slice of bytes into an uint64.
+func SumWide[W, T Number](input []T) W {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return W(SumInt8sToInt64(as[int8](input)))
+	case reflect.Int16:
+		return W(SumInt16sToInt64(as[int16](input)))
+	case reflect.Int32:
+		return W(SumInt32sToInt64(as[int32](input)))
+	case reflect.Int64:
+		return W(SumInt64s(as[int64](input)))
+	case reflect.Uint8:
+		return W(SumUint8sToUint64(as[uint8](input)))
+	case reflect.Uint16:
+		return W(SumUint16sToUint64(as[uint16](input)))
+	case reflect.Uint32:
+		return W(SumUint32sToUint64(as[uint32](input)))
+	case reflect.Uint64:
+		return W(SumUint64s(as[uint64](input)))
+	case reflect.Float32:
+		return W(SumFloat32sToFloat64(as[float32](input)))
+	case reflect.Float64:
+		return W(SumFloat64s(as[float64](input)))
+	default:
+		return sumWide[W](input)
+	}
+}
+
+// sumWide sums up all of the elements of the slice into a wider accumulator and returns the value
+func sumWide[W, T Number](input []T) (sum W) {
+	for _, v := range input {
+		sum += W(v)
+	}
+	return
+}
+
+// Compensated and pairwise sums keep a separate lane for every element of a 64-byte row, which is
+// as wide as the widest vector register, so the order of additions does not depend on the CPU.
+const (
+	lanesFloat32 = 64 / 4
+	lanesFloat64 = 64 / 8
+	rowsPerBlock = 16 // number of rows summed up sequentially in a pairwise sum
+	stackDepth   = 64 // maximum depth of the pairwise merge stack
+)
+
+// SumKahan sums up all of the elements of the slice using compensated (Kahan) summation and returns
+// the value. The order of additions is fixed, so the result is the same for every instruction set.
+func SumKahan[T Float](input []T) T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return T(SumFloat32sKahan(as[float32](input)))
+	case reflect.Float64:
+		return T(SumFloat64sKahan(as[float64](input)))
+	default:
+		return sumKahan(input)
+	}
+}
+
+// sumKahan sums up all of the elements of the slice using compensated summation and returns the value
+func sumKahan[T Float](input []T) T {
+	var sum, err [lanesFloat32]T
+	lanes := lanesOf[T]()
+	rows := len(input) - len(input)%lanes
+	kahanLanes(input[:rows], sum[:lanes], err[:lanes])
+	return kahanTotal(sum[:lanes], err[:lanes], input[rows:])
+}
+
+// SumPairwise sums up all of the elements of the slice using pairwise summation and returns the
+// value. The order of additions is fixed, so the result is the same for every instruction set.
+func SumPairwise[T Float](input []T) T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return T(SumFloat32sPairwise(as[float32](input)))
+	case reflect.Float64:
+		return T(SumFloat64sPairwise(as[float64](input)))
+	default:
+		return sumPairwise(input)
+	}
+}
+
+// sumPairwise sums up all of the elements of the slice using pairwise summation and returns the value
+func sumPairwise[T Float](input []T) T {
+	var stack [stackDepth * lanesFloat32]T
+	lanes := lanesOf[T]()
+	rows := len(input) - len(input)%lanes
+	pairwiseLanes(input[:rows], stack[:stackDepth*lanes], lanes)
+	return pairwiseTotal(stack[:lanes], input[rows:])
+}
+
+// lanesOf returns the number of elements of type T which fit into a 64-byte row
+func lanesOf[T Float]() int {
+	var zero T
+	return 64 / int(unsafe.Sizeof(zero))
+}
+
+// kahan adds the value to the sum, carrying the rounding error in err
+func kahan[T Float](sum, err, value T) (T, T) {
+	y := value - err
+	t := sum + y
+	return t, (t - sum) - y
+}
+
+// kahanLanes sums up every lane of the rows with compensated summation, same as the vectorized
+// functions do. The input must consist of whole rows, each as long as the sum and err slices.
+func kahanLanes[T Float](input, sum, err []T) {
+	for i := 0; i < len(input); i += len(sum) {
+		for j, v := range input[i : i+len(sum)] {
+			sum[j], err[j] = kahan(sum[j], err[j], v)
+		}
+	}
+}
+
+// kahanTotal adds up the lanes and their errors in order, followed by the remaining elements
+func kahanTotal[T Float](sum, err, tail []T) T {
+	var total, c T
+	for j := range sum {
+		total, c = kahan(total, c, sum[j])
+		total, c = kahan(total, c, -err[j])
+	}
+	for _, v := range tail {
+		total, c = kahan(total, c, v)
+	}
+	return total
+}
+
+// pairwiseLanes sums up every lane of the rows with pairwise summation, same as the vectorized
+// functions do. Blocks of rows are summed up sequentially and merged with the previous block of
+// the same size, leaving the total of every lane in the first row of the stack.
+func pairwiseLanes[T Float](input, stack []T, lanes int) {
+	var total [lanesFloat32]T
+	depth, size := 0, rowsPerBlock*lanes
+	for n, i := 0, 0; i < len(input); n, i = n+1, i+size {
+		var sum [lanesFloat32]T
+		end := i + size
+		if end > len(input) {
+			end = len(input)
+		}
+
+		for r := i; r < end; r += lanes {
+			for j, v := range input[r : r+lanes] {
+				sum[j] += v
+			}
+		}
+
+		for carry := n; carry&1 == 1; carry >>= 1 {
+			depth--
+			for j, v := range stack[depth*lanes : depth*lanes+lanes] {
+				sum[j] = v + sum[j]
+			}
+		}
+
+		copy(stack[depth*lanes:], sum[:lanes])
+		depth++
+	}
+
+	for depth > 0 {
+		depth--
+		for j, v := range stack[depth*lanes : depth*lanes+lanes] {
+			total[j] = v + total[j]
+		}
+	}
+	copy(stack, total[:lanes])
+}
+
+// pairwiseTotal folds the lanes in halves until one is left, followed by the remaining elements
+func pairwiseTotal[T Float](lanes, tail []T) T {
+	for w := len(lanes) / 2; w > 0; w /= 2 {
+		for j := 0; j < w; j++ {
+			lanes[j] += lanes[j+w]
+		}
+	}
+
+	total := lanes[0]
+	for _, v := range tail {
+		total += v
+	}
+	return total
+}
+
+// Min returns the smallest element value in the slice. Same as math.Min, -0 is smaller than +0
+// and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
 func Min[T Number](input []T) T {
-	switch v := any(input).(type) {
-	case []int8:
-		return T(MinInt8s(v))
-	case []int16:
-		return T(MinInt16s(v))
-	case []int32:
-		return T(MinInt32s(v))
-	case []int64:
-		return T(MinInt64s(v))
-	case []uint8:
-		return T(MinUint8s(v))
-	case []uint16:
-		return T(MinUint16s(v))
-	case []uint32:
-		return T(MinUint32s(v))
-	case []uint64:
-		return T(MinUint64s(v))
-	case []float32:
-		return T(MinFloat32s(v))
-	case []float64:
-		return T(MinFloat64s(v))
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return T(MinInt8s(as[int8](input)))
+	case reflect.Int16:
+		return T(MinInt16s(as[int16](input)))
+	case reflect.Int32:
+		return T(MinInt32s(as[int32](input)))
+	case reflect.Int64:
+		return T(MinInt64s(as[int64](input)))
+	case reflect.Uint8:
+		return T(MinUint8s(as[uint8](input)))
+	case reflect.Uint16:
+		return T(MinUint16s(as[uint16](input)))
+	case reflect.Uint32:
+		return T(MinUint32s(as[uint32](input)))
+	case reflect.Uint64:
+		return T(MinUint64s(as[uint64](input)))
+	case reflect.Float32:
+		return T(MinFloat32s(as[float32](input)))
+	case reflect.Float64:
+		return T(MinFloat64s(as[float64](input)))
 	default:
 		return min(input)
 	}
 }
 
-// Min returns the smallest element value in the slice
+// Min returns the smallest element value in the slice. It panics if the slice is empty.
 func min[T Number](input []T) T {
 	min := input[0]
 	for _, v := range input[1:] {
-		if v < min {
+		if v < min || v != v {
 			min = v
 		}
 	}
-	return min
+	return zeroOf(input, nil, min, true)
 }
 
-// Max returns the largest element value in the slice
+// zeroOf gives a zero result the sign which math.Min and math.Max would, so that the smallest value
+// is -0 if any of the elements is -0 and the largest is +0 if any of them is +0. The elements are
+// only looked at again when the result is a floating-point zero, and a nil mask selects all of them.
+func zeroOf[T Number](input []T, mask []uint64, value T, negative bool) T {
+	if !isFloat[T]() || value != 0 {
+		return value
+	}
+
+	for i, v := range input {
+		if v == 0 && math.Signbit(float64(v)) == negative && (mask == nil || mask[i/64]&(1<<(i%64)) != 0) {
+			return v
+		}
+	}
+	return value
+}
+
+// NanMin returns the smallest element value in the slice, ignoring NaNs. If all of the elements
+// are NaN, the result is NaN. For integer types it is the same as Min. It panics if the slice is empty.
+func NanMin[T Number](input []T) T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return T(NanMinFloat32s(as[float32](input)))
+	case reflect.Float64:
+		return T(NanMinFloat64s(as[float64](input)))
+	default:
+		return Min(input)
+	}
+}
+
+// NanMin returns the smallest element value in the slice, ignoring NaNs. It panics if the slice is empty.
+func nanmin[T Number](input []T) T {
+	min := input[0]
+	for _, v := range input[1:] {
+		if v < min || min != min {
+			min = v
+		}
+	}
+	return zeroOf(input, nil, min, true)
+}
+
+// Max returns the largest element value in the slice. Same as math.Max, +0 is larger than -0
+// and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
 func Max[T Number](input []T) T {
-	switch v := any(input).(type) {
-	case []int8:
-		return T(MaxInt8s(v))
-	case []int16:
-		return T(MaxInt16s(v))
-	case []int32:
-		return T(MaxInt32s(v))
-	case []int64:
-		return T(MaxInt64s(v))
-	case []uint8:
-		return T(MaxUint8s(v))
-	case []uint16:
-		return T(MaxUint16s(v))
-	case []uint32:
-		return T(MaxUint32s(v))
-	case []uint64:
-		return T(MaxUint64s(v))
-	case []float32:
-		return T(MaxFloat32s(v))
-	case []float64:
-		return T(MaxFloat64s(v))
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return T(MaxInt8s(as[int8](input)))
+	case reflect.Int16:
+		return T(MaxInt16s(as[int16](input)))
+	case reflect.Int32:
+		return T(MaxInt32s(as[int32](input)))
+	case reflect.Int64:
+		return T(MaxInt64s(as[int64](input)))
+	case reflect.Uint8:
+		return T(MaxUint8s(as[uint8](input)))
+	case reflect.Uint16:
+		return T(MaxUint16s(as[uint16](input)))
+	case reflect.Uint32:
+		return T(MaxUint32s(as[uint32](input)))
+	case reflect.Uint64:
+		return T(MaxUint64s(as[uint64](input)))
+	case reflect.Float32:
+		return T(MaxFloat32s(as[float32](input)))
+	case reflect.Float64:
+		return T(MaxFloat64s(as[float64](input)))
 	default:
 		return max(input)
 	}
 }
 
-// Max returns the largest element value in the slice
+// Max returns the largest element value in the slice. It panics if the slice is empty.
 func max[T Number](input []T) T {
 	max := input[0]
 	for _, v := range input[1:] {
-		if v > max {
+		if v > max || v != v {
+			max = v
+		}
+	}
+	return zeroOf(input, nil, max, false)
+}
+
+// NanMax returns the largest element value in the slice, ignoring NaNs. If all of the elements
+// are NaN, the result is NaN. For integer types it is the same as Max. It panics if the slice is empty.
+func NanMax[T Number](input []T) T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return T(NanMaxFloat32s(as[float32](input)))
+	case reflect.Float64:
+		return T(NanMaxFloat64s(as[float64](input)))
+	default:
+		return Max(input)
+	}
+}
+
+// NanMax returns the largest element value in the slice, ignoring NaNs. It panics if the slice is empty.
+func nanmax[T Number](input []T) T {
+	max := input[0]
+	for _, v := range input[1:] {
+		if v > max || max != max {
 			max = v
 		}
 	}
-	return max
+	return zeroOf(input, nil, max, false)
+}
+
+// MinMax returns both the smallest and the largest element values in the slice,
+// reading the input only once. If any of the elements is NaN, both are NaN. It
+// panics if the slice is empty.
+func MinMax[T Number](input []T) (T, T) {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		lo, hi := MinMaxInt8s(as[int8](input))
+		return T(lo), T(hi)
+	case reflect.Int16:
+		lo, hi := MinMaxInt16s(as[int16](input))
+		return T(lo), T(hi)
+	case reflect.Int32:
+		lo, hi := MinMaxInt32s(as[int32](input))
+		return T(lo), T(hi)
+	case reflect.Int64:
+		lo, hi := MinMaxInt64s(as[int64](input))
+		return T(lo), T(hi)
+	case reflect.Uint8:
+		lo, hi := MinMaxUint8s(as[uint8](input))
+		return T(lo), T(hi)
+	case reflect.Uint16:
+		lo, hi := MinMaxUint16s(as[uint16](input))
+		return T(lo), T(hi)
+	case reflect.Uint32:
+		lo, hi := MinMaxUint32s(as[uint32](input))
+		return T(lo), T(hi)
+	case reflect.Uint64:
+		lo, hi := MinMaxUint64s(as[uint64](input))
+		return T(lo), T(hi)
+	case reflect.Float32:
+		lo, hi := MinMaxFloat32s(as[float32](input))
+		return T(lo), T(hi)
+	case reflect.Float64:
+		lo, hi := MinMaxFloat64s(as[float64](input))
+		return T(lo), T(hi)
+	default:
+		return minmax(input)
+	}
+}
+
+// MinMax returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func minmax[T Number](input []T) (lo, hi T) {
+	lo, hi = input[0], input[0]
+	for _, v := range input[1:] {
+		if v < lo || v != v {
+			lo = v
+		}
+		if v > hi || v != v {
+			hi = v
+		}
+	}
+	return zeroOf(input, nil, lo, true), zeroOf(input, nil, hi, false)
+}
+
+// ArgMin returns the index of the smallest element value in the slice. If there are several
+// occurrences of the smallest value, the index of the first one is returned. NaN is treated
+// as the smallest value, same as in Min. It panics if the slice is empty.
+func ArgMin[T Number](input []T) int {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return ArgMinInt8s(as[int8](input))
+	case reflect.Int16:
+		return ArgMinInt16s(as[int16](input))
+	case reflect.Int32:
+		return ArgMinInt32s(as[int32](input))
+	case reflect.Int64:
+		return ArgMinInt64s(as[int64](input))
+	case reflect.Uint8:
+		return ArgMinUint8s(as[uint8](input))
+	case reflect.Uint16:
+		return ArgMinUint16s(as[uint16](input))
+	case reflect.Uint32:
+		return ArgMinUint32s(as[uint32](input))
+	case reflect.Uint64:
+		return ArgMinUint64s(as[uint64](input))
+	case reflect.Float32:
+		return ArgMinFloat32s(as[float32](input))
+	case reflect.Float64:
+		return ArgMinFloat64s(as[float64](input))
+	default:
+		return argmin(input)
+	}
+}
+
+// ArgMin returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func argmin[T Number](input []T) (idx int) {
+	min := input[0]
+	for i := 1; i < len(input) && min == min; i++ {
+		if input[i] < min || input[i] != input[i] {
+			min = input[i]
+			idx = i
+		}
+	}
+	return
+}
+
+// ArgMax returns the index of the largest element value in the slice. If there are several
+// occurrences of the largest value, the index of the first one is returned. NaN is treated
+// as the largest value, same as in Max. It panics if the slice is empty.
+func ArgMax[T Number](input []T) int {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return ArgMaxInt8s(as[int8](input))
+	case reflect.Int16:
+		return ArgMaxInt16s(as[int16](input))
+	case reflect.Int32:
+		return ArgMaxInt32s(as[int32](input))
+	case reflect.Int64:
+		return ArgMaxInt64s(as[int64](input))
+	case reflect.Uint8:
+		return ArgMaxUint8s(as[uint8](input))
+	case reflect.Uint16:
+		return ArgMaxUint16s(as[uint16](input))
+	case reflect.Uint32:
+		return ArgMaxUint32s(as[uint32](input))
+	case reflect.Uint64:
+		return ArgMaxUint64s(as[uint64](input))
+	case reflect.Float32:
+		return ArgMaxFloat32s(as[float32](input))
+	case reflect.Float64:
+		return ArgMaxFloat64s(as[float64](input))
+	default:
+		return argmax(input)
+	}
+}
+
+// ArgMax returns the index of the largest element value in the slice. It panics if the slice is empty.
+func argmax[T Number](input []T) (idx int) {
+	max := input[0]
+	for i := 1; i < len(input) && max == max; i++ {
+		if input[i] > max || input[i] != input[i] {
+			max = input[i]
+			idx = i
+		}
+	}
+	return
+}
+
+// Dot computes the dot product of two slices and returns the value
+func Dot[T Number](input1, input2 []T) T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return T(DotInt8s(as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return T(DotInt16s(as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return T(DotInt32s(as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return T(DotInt64s(as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return T(DotUint8s(as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return T(DotUint16s(as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return T(DotUint32s(as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return T(DotUint64s(as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return T(DotFloat32s(as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return T(DotFloat64s(as[float64](input1), as[float64](input2)))
+	default:
+		return dot(input1, input2)
+	}
+}
+
+// Dot computes the dot product of two slices and returns the value
+func dot[T Number](input1, input2 []T) (sum T) {
+	for i, v := range input1[:shortest(input1, input2)] {
+		sum += v * input2[i]
+	}
+	return
+}
+
+// Add adds input1 to input2 and writes back the result into dst slice
+func Add[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](AddInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](AddInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](AddInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](AddInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](AddUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](AddUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](AddUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](AddUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](AddFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](AddFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return add(dst, input1, input2)
+	}
 }
 
 // Add adds input1 to input2 and writes back the result into dst slice
 func add[T Number](dst, input1, input2 []T) []T {
-	for i, v := range input1 {
+	dst = dst[:shortest(dst, input1, input2)]
+	for i, v := range input1[:len(dst)] {
 		dst[i] = v + input2[i]
 	}
 	return dst
 }
 
+// Sub subtracts input2 from input1 and writes back the result into dst slice
+func Sub[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](SubInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](SubInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](SubInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](SubInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](SubUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](SubUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](SubUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](SubUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](SubFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](SubFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return sub(dst, input1, input2)
+	}
+}
+
 // Sub subtracts input2 from input1 and writes back the result into dst slice
 func sub[T Number](dst, input1, input2 []T) []T {
-	for i, v := range input1 {
+	dst = dst[:shortest(dst, input1, input2)]
+	for i, v := range input1[:len(dst)] {
 		dst[i] = v - input2[i]
 	}
 	return dst
 }
 
+// Mul multiplies input1 by input2 and writes back the result into dst slice
+func Mul[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](MulInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](MulInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](MulInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](MulInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](MulUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](MulUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](MulUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](MulUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](MulFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](MulFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return mul(dst, input1, input2)
+	}
+}
+
 // Mul multiplies input1 by input2 and writes back the result into dst slice
 func mul[T Number](dst, input1, input2 []T) []T {
-	for i, v := range input1 {
+	dst = dst[:shortest(dst, input1, input2)]
+	for i, v := range input1[:len(dst)] {
 		dst[i] = v * input2[i]
 	}
 	return dst
 }
 
+// DivideByZeroError is the value the integer division functions panic with when one of the divisors
+// is zero. Same as the runtime error of dividing by zero in Go, but it also carries the index of the
+// offending element, which is the same regardless of the instruction set.
+type DivideByZeroError struct {
+	Index int // Index of the first element which was divided by zero
+}
+
+// Error returns the error message
+func (e *DivideByZeroError) Error() string {
+	return "runtime error: integer divide by zero at index " + strconv.Itoa(e.Index)
+}
+
+// RuntimeError marks the error as a runtime.Error, same as a division by zero in Go
+func (e *DivideByZeroError) RuntimeError() {}
+
+// Div divides input1 by input2 and writes back the result into dst slice. For integer types,
+// it panics with a *DivideByZeroError if any of the divisors is zero.
+func Div[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](DivInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](DivInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](DivInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](DivInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](DivUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](DivUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](DivUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](DivUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](DivFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](DivFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return div(dst, input1, input2)
+	}
+}
+
 // Div divides input1 by input2 and writes back the result into dst slice
 func div[T Number](dst, input1, input2 []T) []T {
-	for i, v := range input1 {
+	dst = dst[:shortest(dst, input1, input2)]
+	checked := !isFloat[T]()
+	for i, v := range input1[:len(dst)] {
+		if checked && input2[i] == 0 {
+			panic(&DivideByZeroError{Index: i})
+		}
 		dst[i] = v / input2[i]
 	}
 	return dst
 }
+
+// MinOf writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN, and if they are equal, such as -0 and
+// +0, the element of input2 is written.
+func MinOf[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](MinOfInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](MinOfInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](MinOfInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](MinOfInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](MinOfUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](MinOfUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](MinOfUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](MinOfUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](MinOfFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](MinOfFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return minOf(dst, input1, input2)
+	}
+}
+
+// MinOf writes back the smaller of each pair of elements of input1 and input2 into dst slice
+func minOf[T Number](dst, input1, input2 []T) []T {
+	dst = dst[:shortest(dst, input1, input2)]
+	for i, v := range input1[:len(dst)] {
+		if w := input2[i]; v < w || v != v {
+			dst[i] = v
+		} else {
+			dst[i] = w
+		}
+	}
+	return dst
+}
+
+// MaxOf writes back the larger of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN, and if they are equal, such as -0 and
+// +0, the element of input2 is written.
+func MaxOf[T Number](dst, input1, input2 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](MaxOfInt8s(as[int8](dst), as[int8](input1), as[int8](input2)))
+	case reflect.Int16:
+		return as[T](MaxOfInt16s(as[int16](dst), as[int16](input1), as[int16](input2)))
+	case reflect.Int32:
+		return as[T](MaxOfInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+	case reflect.Int64:
+		return as[T](MaxOfInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	case reflect.Uint8:
+		return as[T](MaxOfUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2)))
+	case reflect.Uint16:
+		return as[T](MaxOfUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2)))
+	case reflect.Uint32:
+		return as[T](MaxOfUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+	case reflect.Uint64:
+		return as[T](MaxOfUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	case reflect.Float32:
+		return as[T](MaxOfFloat32s(as[float32](dst), as[float32](input1), as[float32](input2)))
+	case reflect.Float64:
+		return as[T](MaxOfFloat64s(as[float64](dst), as[float64](input1), as[float64](input2)))
+	default:
+		return maxOf(dst, input1, input2)
+	}
+}
+
+// MaxOf writes back the larger of each pair of elements of input1 and input2 into dst slice
+func maxOf[T Number](dst, input1, input2 []T) []T {
+	dst = dst[:shortest(dst, input1, input2)]
+	for i, v := range input1[:len(dst)] {
+		if w := input2[i]; v > w || v != v {
+			dst[i] = v
+		} else {
+			dst[i] = w
+		}
+	}
+	return dst
+}
+
+// Clamp limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi. If either the element or a bound is NaN, the result is NaN.
+func Clamp[T Number](dst, input []T, lo, hi T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](ClampInt8s(as[int8](dst), as[int8](input), int8(lo), int8(hi)))
+	case reflect.Int16:
+		return as[T](ClampInt16s(as[int16](dst), as[int16](input), int16(lo), int16(hi)))
+	case reflect.Int32:
+		return as[T](ClampInt32s(as[int32](dst), as[int32](input), int32(lo), int32(hi)))
+	case reflect.Int64:
+		return as[T](ClampInt64s(as[int64](dst), as[int64](input), int64(lo), int64(hi)))
+	case reflect.Uint8:
+		return as[T](ClampUint8s(as[uint8](dst), as[uint8](input), uint8(lo), uint8(hi)))
+	case reflect.Uint16:
+		return as[T](ClampUint16s(as[uint16](dst), as[uint16](input), uint16(lo), uint16(hi)))
+	case reflect.Uint32:
+		return as[T](ClampUint32s(as[uint32](dst), as[uint32](input), uint32(lo), uint32(hi)))
+	case reflect.Uint64:
+		return as[T](ClampUint64s(as[uint64](dst), as[uint64](input), uint64(lo), uint64(hi)))
+	case reflect.Float32:
+		return as[T](ClampFloat32s(as[float32](dst), as[float32](input), float32(lo), float32(hi)))
+	case reflect.Float64:
+		return as[T](ClampFloat64s(as[float64](dst), as[float64](input), float64(lo), float64(hi)))
+	default:
+		return clamp(dst, input, lo, hi)
+	}
+}
+
+// Clamp limits each element of the input to the [lo, hi] range and writes back the result into dst slice
+func clamp[T Number](dst, input []T, lo, hi T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		if !(v > lo || v != v) {
+			v = lo
+		}
+		if !(v < hi || v != v) {
+			v = hi
+		}
+		dst[i] = v
+	}
+	return dst
+}
+
+// Equals sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func Equals[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return EqualsInt8s(as[int8](input), int8(value), bitmap)
+	case reflect.Int16:
+		return EqualsInt16s(as[int16](input), int16(value), bitmap)
+	case reflect.Int32:
+		return EqualsInt32s(as[int32](input), int32(value), bitmap)
+	case reflect.Int64:
+		return EqualsInt64s(as[int64](input), int64(value), bitmap)
+	case reflect.Uint8:
+		return EqualsUint8s(as[uint8](input), uint8(value), bitmap)
+	case reflect.Uint16:
+		return EqualsUint16s(as[uint16](input), uint16(value), bitmap)
+	case reflect.Uint32:
+		return EqualsUint32s(as[uint32](input), uint32(value), bitmap)
+	case reflect.Uint64:
+		return EqualsUint64s(as[uint64](input), uint64(value), bitmap)
+	case reflect.Float32:
+		return EqualsFloat32s(as[float32](input), float32(value), bitmap)
+	case reflect.Float64:
+		return EqualsFloat64s(as[float64](input), float64(value), bitmap)
+	default:
+		return equals(input, value, bitmap)
+	}
+}
+
+// Equals sets a bit in the bitmap for each element of the input which is equal to value
+func equals[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	for i, v := range input {
+		if v == value {
+			bitmap[i/64] |= 1 << (i % 64)
+		}
+	}
+	return bitmap
+}
+
+// LessThan sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThan[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return LessThanInt8s(as[int8](input), int8(value), bitmap)
+	case reflect.Int16:
+		return LessThanInt16s(as[int16](input), int16(value), bitmap)
+	case reflect.Int32:
+		return LessThanInt32s(as[int32](input), int32(value), bitmap)
+	case reflect.Int64:
+		return LessThanInt64s(as[int64](input), int64(value), bitmap)
+	case reflect.Uint8:
+		return LessThanUint8s(as[uint8](input), uint8(value), bitmap)
+	case reflect.Uint16:
+		return LessThanUint16s(as[uint16](input), uint16(value), bitmap)
+	case reflect.Uint32:
+		return LessThanUint32s(as[uint32](input), uint32(value), bitmap)
+	case reflect.Uint64:
+		return LessThanUint64s(as[uint64](input), uint64(value), bitmap)
+	case reflect.Float32:
+		return LessThanFloat32s(as[float32](input), float32(value), bitmap)
+	case reflect.Float64:
+		return LessThanFloat64s(as[float64](input), float64(value), bitmap)
+	default:
+		return lessThan(input, value, bitmap)
+	}
+}
+
+// LessThan sets a bit in the bitmap for each element of the input which is less than value
+func lessThan[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	for i, v := range input {
+		if v < value {
+			bitmap[i/64] |= 1 << (i % 64)
+		}
+	}
+	return bitmap
+}
+
+// GreaterThan sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThan[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return GreaterThanInt8s(as[int8](input), int8(value), bitmap)
+	case reflect.Int16:
+		return GreaterThanInt16s(as[int16](input), int16(value), bitmap)
+	case reflect.Int32:
+		return GreaterThanInt32s(as[int32](input), int32(value), bitmap)
+	case reflect.Int64:
+		return GreaterThanInt64s(as[int64](input), int64(value), bitmap)
+	case reflect.Uint8:
+		return GreaterThanUint8s(as[uint8](input), uint8(value), bitmap)
+	case reflect.Uint16:
+		return GreaterThanUint16s(as[uint16](input), uint16(value), bitmap)
+	case reflect.Uint32:
+		return GreaterThanUint32s(as[uint32](input), uint32(value), bitmap)
+	case reflect.Uint64:
+		return GreaterThanUint64s(as[uint64](input), uint64(value), bitmap)
+	case reflect.Float32:
+		return GreaterThanFloat32s(as[float32](input), float32(value), bitmap)
+	case reflect.Float64:
+		return GreaterThanFloat64s(as[float64](input), float64(value), bitmap)
+	default:
+		return greaterThan(input, value, bitmap)
+	}
+}
+
+// GreaterThan sets a bit in the bitmap for each element of the input which is greater than value
+func greaterThan[T Number](input []T, value T, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	for i, v := range input {
+		if v > value {
+			bitmap[i/64] |= 1 << (i % 64)
+		}
+	}
+	return bitmap
+}
+
+// Between sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func Between[T Number](input []T, lo, hi T, bitmap []uint64) []uint64 {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return BetweenInt8s(as[int8](input), int8(lo), int8(hi), bitmap)
+	case reflect.Int16:
+		return BetweenInt16s(as[int16](input), int16(lo), int16(hi), bitmap)
+	case reflect.Int32:
+		return BetweenInt32s(as[int32](input), int32(lo), int32(hi), bitmap)
+	case reflect.Int64:
+		return BetweenInt64s(as[int64](input), int64(lo), int64(hi), bitmap)
+	case reflect.Uint8:
+		return BetweenUint8s(as[uint8](input), uint8(lo), uint8(hi), bitmap)
+	case reflect.Uint16:
+		return BetweenUint16s(as[uint16](input), uint16(lo), uint16(hi), bitmap)
+	case reflect.Uint32:
+		return BetweenUint32s(as[uint32](input), uint32(lo), uint32(hi), bitmap)
+	case reflect.Uint64:
+		return BetweenUint64s(as[uint64](input), uint64(lo), uint64(hi), bitmap)
+	case reflect.Float32:
+		return BetweenFloat32s(as[float32](input), float32(lo), float32(hi), bitmap)
+	case reflect.Float64:
+		return BetweenFloat64s(as[float64](input), float64(lo), float64(hi), bitmap)
+	default:
+		return between(input, lo, hi, bitmap)
+	}
+}
+
+// Between sets a bit in the bitmap for each element of the input which is within the [lo, hi] range
+func between[T Number](input []T, lo, hi T, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	for i, v := range input {
+		if v >= lo && v <= hi {
+			bitmap[i/64] |= 1 << (i % 64)
+		}
+	}
+	return bitmap
+}
+
+// Comparison is an operator used to compare pairs of elements with Compare
+type Comparison uint8
+
+// Supported comparison operators
+const (
+	Equal        Comparison = iota // input1[i] == input2[i]
+	NotEqual                       // input1[i] != input2[i]
+	Less                           // input1[i] < input2[i]
+	LessEqual                      // input1[i] <= input2[i]
+	Greater                        // input1[i] > input2[i]
+	GreaterEqual                   // input1[i] >= input2[i]
+)
+
+// String returns the Go operator of the comparison
+func (op Comparison) String() string {
+	switch op {
+	case Equal:
+		return "=="
+	case NotEqual:
+		return "!="
+	case Less:
+		return "<"
+	case LessEqual:
+		return "<="
+	case Greater:
+		return ">"
+	case GreaterEqual:
+		return ">="
+	default:
+		return "Comparison(" + strconv.Itoa(int(op)) + ")"
+	}
+}
+
+// Compare sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func Compare[T Number](input1, input2 []T, op Comparison, bitmap []uint64) []uint64 {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return CompareInt8s(as[int8](input1), as[int8](input2), op, bitmap)
+	case reflect.Int16:
+		return CompareInt16s(as[int16](input1), as[int16](input2), op, bitmap)
+	case reflect.Int32:
+		return CompareInt32s(as[int32](input1), as[int32](input2), op, bitmap)
+	case reflect.Int64:
+		return CompareInt64s(as[int64](input1), as[int64](input2), op, bitmap)
+	case reflect.Uint8:
+		return CompareUint8s(as[uint8](input1), as[uint8](input2), op, bitmap)
+	case reflect.Uint16:
+		return CompareUint16s(as[uint16](input1), as[uint16](input2), op, bitmap)
+	case reflect.Uint32:
+		return CompareUint32s(as[uint32](input1), as[uint32](input2), op, bitmap)
+	case reflect.Uint64:
+		return CompareUint64s(as[uint64](input1), as[uint64](input2), op, bitmap)
+	case reflect.Float32:
+		return CompareFloat32s(as[float32](input1), as[float32](input2), op, bitmap)
+	case reflect.Float64:
+		return CompareFloat64s(as[float64](input1), as[float64](input2), op, bitmap)
+	default:
+		return compare(input1, input2, op, bitmap)
+	}
+}
+
+// Compare sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison holds
+func compare[T Number](input1, input2 []T, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	for i := range bitmap {
+		bitmap[i] = 0
+	}
+	for i, v := range input1 {
+		if matches(v, input2[i], op) {
+			bitmap[i/64] |= 1 << (i % 64)
+		}
+	}
+	return bitmap
+}
+
+// matches returns whether the comparison holds for a pair of elements, and panics on an unknown operator
+func matches[T Number](a, b T, op Comparison) bool {
+	switch op {
+	case Equal:
+		return a == b
+	case NotEqual:
+		return a != b
+	case Less:
+		return a < b
+	case LessEqual:
+		return a <= b
+	case Greater:
+		return a > b
+	case GreaterEqual:
+		return a >= b
+	default:
+		panic("simd: unknown comparison " + op.String())
+	}
+}
+
+// SumMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumMasked[T Number](input []T, mask []uint64) T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return T(SumInt8sMasked(as[int8](input), mask))
+	case reflect.Int16:
+		return T(SumInt16sMasked(as[int16](input), mask))
+	case reflect.Int32:
+		return T(SumInt32sMasked(as[int32](input), mask))
+	case reflect.Int64:
+		return T(SumInt64sMasked(as[int64](input), mask))
+	case reflect.Uint8:
+		return T(SumUint8sMasked(as[uint8](input), mask))
+	case reflect.Uint16:
+		return T(SumUint16sMasked(as[uint16](input), mask))
+	case reflect.Uint32:
+		return T(SumUint32sMasked(as[uint32](input), mask))
+	case reflect.Uint64:
+		return T(SumUint64sMasked(as[uint64](input), mask))
+	case reflect.Float32:
+		return T(SumFloat32sMasked(as[float32](input), mask))
+	case reflect.Float64:
+		return T(SumFloat64sMasked(as[float64](input), mask))
+	default:
+		return sumMasked(input, mask)
+	}
+}
+
+// SumMasked sums up the elements of the slice whose bit is set in the mask and returns the value
+func sumMasked[T Number](input []T, mask []uint64) T {
+	input, mask = bitmapOf(input, mask)
+	var sum T
+	for i, v := range input {
+		if mask[i/64]&(1<<(i%64)) != 0 {
+			sum += v
+		}
+	}
+	return sum
+}
+
+// MinMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MinMasked[T Number](input []T, mask []uint64) (T, bool) {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		value, ok := MinInt8sMasked(as[int8](input), mask)
+		return T(value), ok
+	case reflect.Int16:
+		value, ok := MinInt16sMasked(as[int16](input), mask)
+		return T(value), ok
+	case reflect.Int32:
+		value, ok := MinInt32sMasked(as[int32](input), mask)
+		return T(value), ok
+	case reflect.Int64:
+		value, ok := MinInt64sMasked(as[int64](input), mask)
+		return T(value), ok
+	case reflect.Uint8:
+		value, ok := MinUint8sMasked(as[uint8](input), mask)
+		return T(value), ok
+	case reflect.Uint16:
+		value, ok := MinUint16sMasked(as[uint16](input), mask)
+		return T(value), ok
+	case reflect.Uint32:
+		value, ok := MinUint32sMasked(as[uint32](input), mask)
+		return T(value), ok
+	case reflect.Uint64:
+		value, ok := MinUint64sMasked(as[uint64](input), mask)
+		return T(value), ok
+	case reflect.Float32:
+		value, ok := MinFloat32sMasked(as[float32](input), mask)
+		return T(value), ok
+	case reflect.Float64:
+		value, ok := MinFloat64sMasked(as[float64](input), mask)
+		return T(value), ok
+	default:
+		return minMasked(input, mask)
+	}
+}
+
+// MinMasked returns the smallest element value of the slice among those whose bit is set in the mask
+func minMasked[T Number](input []T, mask []uint64) (T, bool) {
+	input, mask = bitmapOf(input, mask)
+	first, ok := firstOf(input, mask)
+	if !ok {
+		return 0, false
+	}
+
+	min := input[first]
+	for i := first + 1; i < len(input); i++ {
+		if v := input[i]; mask[i/64]&(1<<(i%64)) != 0 && (v < min || v != v) {
+			min = v
+		}
+	}
+	return zeroOf(input, mask, min, true), true
+}
+
+// MaxMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MaxMasked[T Number](input []T, mask []uint64) (T, bool) {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		value, ok := MaxInt8sMasked(as[int8](input), mask)
+		return T(value), ok
+	case reflect.Int16:
+		value, ok := MaxInt16sMasked(as[int16](input), mask)
+		return T(value), ok
+	case reflect.Int32:
+		value, ok := MaxInt32sMasked(as[int32](input), mask)
+		return T(value), ok
+	case reflect.Int64:
+		value, ok := MaxInt64sMasked(as[int64](input), mask)
+		return T(value), ok
+	case reflect.Uint8:
+		value, ok := MaxUint8sMasked(as[uint8](input), mask)
+		return T(value), ok
+	case reflect.Uint16:
+		value, ok := MaxUint16sMasked(as[uint16](input), mask)
+		return T(value), ok
+	case reflect.Uint32:
+		value, ok := MaxUint32sMasked(as[uint32](input), mask)
+		return T(value), ok
+	case reflect.Uint64:
+		value, ok := MaxUint64sMasked(as[uint64](input), mask)
+		return T(value), ok
+	case reflect.Float32:
+		value, ok := MaxFloat32sMasked(as[float32](input), mask)
+		return T(value), ok
+	case reflect.Float64:
+		value, ok := MaxFloat64sMasked(as[float64](input), mask)
+		return T(value), ok
+	default:
+		return maxMasked(input, mask)
+	}
+}
+
+// MaxMasked returns the largest element value of the slice among those whose bit is set in the mask
+func maxMasked[T Number](input []T, mask []uint64) (T, bool) {
+	input, mask = bitmapOf(input, mask)
+	first, ok := firstOf(input, mask)
+	if !ok {
+		return 0, false
+	}
+
+	max := input[first]
+	for i := first + 1; i < len(input); i++ {
+		if v := input[i]; mask[i/64]&(1<<(i%64)) != 0 && (v > max || v != v) {
+			max = v
+		}
+	}
+	return zeroOf(input, mask, max, false), true
+}
+
+// CountMasked returns the number of bits set among the first n bits of the mask, which is the number of
+// elements a masked aggregate over a slice of length n would take.
+func CountMasked(n int, mask []uint64) int {
+	if n > len(mask)*64 {
+		n = len(mask) * 64
+	}
+
+	count := 0
+	for i, word := range mask[:(n+63)/64] {
+		if rest := n - i*64; rest < 64 {
+			word &= 1<<rest - 1
+		}
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Compress copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full. The 8-bit and 16-bit elements
+// are only vectorized on AVX-512 CPUs with VBMI2, and are otherwise copied one at a time in Go.
+func Compress[T Number](dst, input []T, mask []uint64) int {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return CompressInt8s(as[int8](dst), as[int8](input), mask)
+	case reflect.Int16:
+		return CompressInt16s(as[int16](dst), as[int16](input), mask)
+	case reflect.Int32:
+		return CompressInt32s(as[int32](dst), as[int32](input), mask)
+	case reflect.Int64:
+		return CompressInt64s(as[int64](dst), as[int64](input), mask)
+	case reflect.Uint8:
+		return CompressUint8s(as[uint8](dst), as[uint8](input), mask)
+	case reflect.Uint16:
+		return CompressUint16s(as[uint16](dst), as[uint16](input), mask)
+	case reflect.Uint32:
+		return CompressUint32s(as[uint32](dst), as[uint32](input), mask)
+	case reflect.Uint64:
+		return CompressUint64s(as[uint64](dst), as[uint64](input), mask)
+	case reflect.Float32:
+		return CompressFloat32s(as[float32](dst), as[float32](input), mask)
+	case reflect.Float64:
+		return CompressFloat64s(as[float64](dst), as[float64](input), mask)
+	default:
+		return compress(dst, input, mask)
+	}
+}
+
+// Compress copies the elements of the input whose bit is set in the mask next to each other into dst slice
+func compress[T Number](dst, input []T, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	n := 0
+	for i, v := range input {
+		if mask[i/64]&(1<<(i%64)) == 0 {
+			continue
+		}
+		if n == len(dst) {
+			break
+		}
+		dst[n] = v
+		n++
+	}
+	return n
+}
+
+// Select writes back the element of input1 into dst slice where its bit is set in the mask, and the element
+// of input2 otherwise. Elements beyond the end of the mask are not written.
+func Select[T Number](dst, input1, input2 []T, mask []uint64) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](SelectInt8s(as[int8](dst), as[int8](input1), as[int8](input2), mask))
+	case reflect.Int16:
+		return as[T](SelectInt16s(as[int16](dst), as[int16](input1), as[int16](input2), mask))
+	case reflect.Int32:
+		return as[T](SelectInt32s(as[int32](dst), as[int32](input1), as[int32](input2), mask))
+	case reflect.Int64:
+		return as[T](SelectInt64s(as[int64](dst), as[int64](input1), as[int64](input2), mask))
+	case reflect.Uint8:
+		return as[T](SelectUint8s(as[uint8](dst), as[uint8](input1), as[uint8](input2), mask))
+	case reflect.Uint16:
+		return as[T](SelectUint16s(as[uint16](dst), as[uint16](input1), as[uint16](input2), mask))
+	case reflect.Uint32:
+		return as[T](SelectUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2), mask))
+	case reflect.Uint64:
+		return as[T](SelectUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2), mask))
+	case reflect.Float32:
+		return as[T](SelectFloat32s(as[float32](dst), as[float32](input1), as[float32](input2), mask))
+	case reflect.Float64:
+		return as[T](SelectFloat64s(as[float64](dst), as[float64](input1), as[float64](input2), mask))
+	default:
+		return blend(dst, input1, input2, mask)
+	}
+}
+
+// Select writes back the element of input1 into dst slice where its bit is set in the mask, and the element of input2 otherwise
+func blend[T Number](dst, input1, input2 []T, mask []uint64) []T {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	for i := range dst {
+		if mask[i/64]&(1<<(i%64)) != 0 {
+			dst[i] = input1[i]
+		} else {
+			dst[i] = input2[i]
+		}
+	}
+	return dst
+}
+
+// SelectScalar writes back the element of the input into dst slice where its bit is set in the mask, and the
+// value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalar[T Number](dst, input []T, mask []uint64, value T) []T {
+	switch kindOf[T]() {
+	case reflect.Int8:
+		return as[T](SelectScalarInt8s(as[int8](dst), as[int8](input), mask, int8(value)))
+	case reflect.Int16:
+		return as[T](SelectScalarInt16s(as[int16](dst), as[int16](input), mask, int16(value)))
+	case reflect.Int32:
+		return as[T](SelectScalarInt32s(as[int32](dst), as[int32](input), mask, int32(value)))
+	case reflect.Int64:
+		return as[T](SelectScalarInt64s(as[int64](dst), as[int64](input), mask, int64(value)))
+	case reflect.Uint8:
+		return as[T](SelectScalarUint8s(as[uint8](dst), as[uint8](input), mask, uint8(value)))
+	case reflect.Uint16:
+		return as[T](SelectScalarUint16s(as[uint16](dst), as[uint16](input), mask, uint16(value)))
+	case reflect.Uint32:
+		return as[T](SelectScalarUint32s(as[uint32](dst), as[uint32](input), mask, uint32(value)))
+	case reflect.Uint64:
+		return as[T](SelectScalarUint64s(as[uint64](dst), as[uint64](input), mask, uint64(value)))
+	case reflect.Float32:
+		return as[T](SelectScalarFloat32s(as[float32](dst), as[float32](input), mask, float32(value)))
+	case reflect.Float64:
+		return as[T](SelectScalarFloat64s(as[float64](dst), as[float64](input), mask, float64(value)))
+	default:
+		return blendScalar(dst, input, mask, value)
+	}
+}
+
+// SelectScalar writes back the element of the input into dst slice where its bit is set in the mask, and the value otherwise
+func blendScalar[T Number](dst, input []T, mask []uint64, value T) []T {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	for i := range dst {
+		if mask[i/64]&(1<<(i%64)) != 0 {
+			dst[i] = input[i]
+		} else {
+			dst[i] = value
+		}
+	}
+	return dst
+}
+
+// compressible returns how many elements of the input, in whole words of the mask, can be compressed
+// into dst slice, along with the number of elements they select
+func compressible[T Number](dst, input []T, mask []uint64) (rows, count int) {
+	for _, word := range mask[:len(input)/64] {
+		n := bits.OnesCount64(word)
+		if count+n > len(dst) {
+			break
+		}
+		rows, count = rows+64, count+n
+	}
+	return
+}
+
+// firstOf returns the index of the first element of the input whose bit is set in the mask
+func firstOf[T Number](input []T, mask []uint64) (int, bool) {
+	for i, word := range mask {
+		if word != 0 {
+			first := i*64 + bits.TrailingZeros64(word)
+			return first, first < len(input)
+		}
+	}
+	return 0, false
+}
+
+// bitmapOf truncates the input to as many elements as there are bits in the bitmap, and the bitmap
+// to as many words as needed for the input
+func bitmapOf[T Number](input []T, bitmap []uint64) ([]T, []uint64) {
+	if len(input) > len(bitmap)*64 {
+		input = input[:len(bitmap)*64]
+	}
+	return input, bitmap[:(len(input)+63)/64]
+}
+
+// FMA multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func FMA[T Float](dst, input1, input2, input3 []T) []T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return as[T](FMAFloat32s(as[float32](dst), as[float32](input1), as[float32](input2), as[float32](input3)))
+	case reflect.Float64:
+		return as[T](FMAFloat64s(as[float64](dst), as[float64](input1), as[float64](input2), as[float64](input3)))
+	default:
+		return fmadd(dst, input1, input2, input3)
+	}
+}
+
+// FMA multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func fmadd[T Float](dst, input1, input2, input3 []T) []T {
+	dst = dst[:shortest(dst, input1, input2, input3)]
+	for i, v := range input1[:len(dst)] {
+		dst[i] = v*input2[i] + input3[i]
+	}
+	return dst
+}
+
+// AXPY multiplies x by alpha, adds y and writes back the result into dst slice
+func AXPY[T Float](dst []T, alpha T, x, y []T) []T {
+	switch kindOf[T]() {
+	case reflect.Float32:
+		return as[T](AXPYFloat32s(as[float32](dst), float32(alpha), as[float32](x), as[float32](y)))
+	case reflect.Float64:
+		return as[T](AXPYFloat64s(as[float64](dst), float64(alpha), as[float64](x), as[float64](y)))
+	default:
+		return axpy(dst, alpha, x, y)
+	}
+}
+
+// AXPY multiplies x by alpha, adds y and writes back the result into dst slice
+func axpy[T Float](dst []T, alpha T, x, y []T) []T {
+	dst = dst[:shortest(dst, x, y)]
+	for i, v := range x[:len(dst)] {
+		dst[i] = alpha*v + y[i]
+	}
+	return dst
+}
+
+// AddScalar adds value to each element of the input and writes back the result into dst slice
+func addScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		dst[i] = v + value
+	}
+	return dst
+}
+
+// SubScalar subtracts value from each element of the input and writes back the result into dst slice
+func subScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		dst[i] = v - value
+	}
+	return dst
+}
+
+// RevSubScalar subtracts each element of the input from value and writes back the result into dst slice
+func rsubScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		dst[i] = value - v
+	}
+	return dst
+}
+
+// MulScalar multiplies each element of the input by value and writes back the result into dst slice
+func mulScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		dst[i] = v * value
+	}
+	return dst
+}
+
+// DivScalar divides each element of the input by value and writes back the result into dst slice
+func divScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	if value == 0 && len(dst) > 0 && !isFloat[T]() {
+		panic(&DivideByZeroError{})
+	}
+	for i, v := range input[:len(dst)] {
+		dst[i] = v / value
+	}
+	return dst
+}
+
+// RevDivScalar divides value by each element of the input and writes back the result into dst slice
+func rdivScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	checked := !isFloat[T]()
+	for i, v := range input[:len(dst)] {
+		if checked && v == 0 {
+			panic(&DivideByZeroError{Index: i})
+		}
+		dst[i] = value / v
+	}
+	return dst
+}
+
+// MinOfScalar writes back the smaller of each element of the input and value into dst slice
+func minOfScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		if v < value || v != v {
+			dst[i] = v
+		} else {
+			dst[i] = value
+		}
+	}
+	return dst
+}
+
+// MaxOfScalar writes back the larger of each element of the input and value into dst slice
+func maxOfScalar[T Number](dst, input []T, value T) []T {
+	dst = dst[:shortest(dst, input)]
+	for i, v := range input[:len(dst)] {
+		if v > value || v != v {
+			dst[i] = v
+		} else {
+			dst[i] = value
+		}
+	}
+	return dst
+}
+
+// reciprocal returns the magic number, shift and sign which divide an integer by the divisor with a
+// multiply and shift, as in Hacker's Delight. It returns false if the divisor is either 1 or -1, for
+// which there is no such magic number, and panics if the divisor is zero.
+func reciprocal[T Integer](divisor T) (magic [3]T, ok bool) {
+	switch {
+	case divisor == 0:
+		panic(&DivideByZeroError{})
+	case divisor == 1, divisor == ^T(0) && ^T(0) < 0:
+		return magic, false
+	}
+
+	size := uint(unsafe.Sizeof(divisor)) * 8
+	mask := ^uint64(0) >> (64 - size)
+	d := uint64(divisor) & mask
+	if divisor < 0 {
+		d = uint64(-divisor) & mask
+		magic[2] = ^T(0)
+	}
+
+	// Powers of two are a plain shift, the multiplication by zero cancels out
+	shift := uint(bits.Len64(d)) - 1
+	if d&(d-1) == 0 {
+		magic[1] = T(shift - 1)
+		return magic, true
+	}
+
+	// The magic number is 2^(size+shift)/d rounded up, without its implicit top bit
+	var m, rem uint64
+	if size == 64 {
+		m, rem = bits.Div64(1<<shift, 0, d)
+	} else {
+		m, rem = (1<<(size+shift))/d, (1<<(size+shift))%d
+	}
+
+	m += m
+	if twice := rem + rem; twice >= d || twice < rem {
+		m++
+	}
+
+	magic[0] = T(m + 1)
+	magic[1] = T(shift)
+	return magic, true
+}
@@ -6,718 +6,3631 @@
 
 package simd
 
-import (
-	"unsafe"
-)
+// table represents a set of functions for a particular element type T, with W being its
+// wider type, which are selected once for the active instruction set.
+type table[T, W Number] struct {
+	sum         func(input []T) T
+	sumWide     func(input []T) W
+	min         func(input []T) T
+	max         func(input []T) T
+	nanmin      func(input []T) T
+	nanmax      func(input []T) T
+	sumKahan    func(input []T) T
+	sumPairwise func(input []T) T
+	minmax      func(input []T) (T, T)
+	argmin      func(input []T) int
+	argmax      func(input []T) int
+	dot         func(input1, input2 []T) T
+	add         func(dst, input1, input2 []T) []T
+	sub         func(dst, input1, input2 []T) []T
+	mul         func(dst, input1, input2 []T) []T
+	div         func(dst, input1, input2 []T) []T
+	addScalar   func(dst, input []T, value T) []T
+	subScalar   func(dst, input []T, value T) []T
+	rsubScalar  func(dst, input []T, value T) []T
+	mulScalar   func(dst, input []T, value T) []T
+	divScalar   func(dst, input []T, value T) []T
+	rdivScalar  func(dst, input []T, value T) []T
+	minOf       func(dst, input1, input2 []T) []T
+	maxOf       func(dst, input1, input2 []T) []T
+	minOfScalar func(dst, input []T, value T) []T
+	maxOfScalar func(dst, input []T, value T) []T
+	clamp       func(dst, input []T, lo, hi T) []T
+	equals      func(input []T, value T, bitmap []uint64) []uint64
+	lessThan    func(input []T, value T, bitmap []uint64) []uint64
+	greaterThan func(input []T, value T, bitmap []uint64) []uint64
+	between     func(input []T, lo, hi T, bitmap []uint64) []uint64
+	compare     func(input1, input2 []T, op Comparison, bitmap []uint64) []uint64
+	sumMasked   func(input []T, mask []uint64) T
+	minMasked   func(input []T, mask []uint64) (T, bool)
+	maxMasked   func(input []T, mask []uint64) (T, bool)
+	compress    func(dst, input []T, mask []uint64) int
+	blend       func(dst, input1, input2 []T, mask []uint64) []T
+	blendScalar func(dst, input []T, mask []uint64, value T) []T
+	fma         func(dst, input1, input2, input3 []T) []T
+	axpy        func(dst []T, alpha T, x, y []T) []T
+}
+
+// dispatch populates the function tables for the active instruction set
+func dispatch() {
+	switch {
+	case avx512:
+		tableUint8 = avx512Uint8s()
+		tableUint16 = avx512Uint16s()
+		tableUint32 = avx512Uint32s()
+		tableUint64 = avx512Uint64s()
+		tableInt8 = avx512Int8s()
+		tableInt16 = avx512Int16s()
+		tableInt32 = avx512Int32s()
+		tableInt64 = avx512Int64s()
+		tableFloat32 = avx512Float32s()
+		tableFloat64 = avx512Float64s()
+	case avx2:
+		tableUint8 = avx2Uint8s()
+		tableUint16 = avx2Uint16s()
+		tableUint32 = avx2Uint32s()
+		tableUint64 = avx2Uint64s()
+		tableInt8 = avx2Int8s()
+		tableInt16 = avx2Int16s()
+		tableInt32 = avx2Int32s()
+		tableInt64 = avx2Int64s()
+		tableFloat32 = avx2Float32s()
+		tableFloat64 = avx2Float64s()
+	case sse:
+		tableUint8 = sseUint8s()
+		tableUint16 = sseUint16s()
+		tableUint32 = sseUint32s()
+		tableUint64 = sseUint64s()
+		tableInt8 = sseInt8s()
+		tableInt16 = sseInt16s()
+		tableInt32 = sseInt32s()
+		tableInt64 = sseInt64s()
+		tableFloat32 = sseFloat32s()
+		tableFloat64 = sseFloat64s()
+	default:
+		tableUint8 = genericUint8s()
+		tableUint16 = genericUint16s()
+		tableUint32 = genericUint32s()
+		tableUint64 = genericUint64s()
+		tableInt8 = genericInt8s()
+		tableInt16 = genericInt16s()
+		tableInt32 = genericInt32s()
+		tableInt64 = genericInt64s()
+		tableFloat32 = genericFloat32s()
+		tableFloat64 = genericFloat64s()
+	}
+}
 
+// short is the length below which the tables sum up in Go, since for a few elements the call
+// into a kernel costs more than the loop itself
+const short = 4
 
 // ---------------------------------- Uint8 ----------------------------------
 
+// tableUint8 is the function table for uint8, populated by dispatch
+var tableUint8 table[uint8, uint64]
+
+// genericUint8s returns the table of generic functions for uint8
+func genericUint8s() (t table[uint8, uint64]) {
+	t.sum = sum[uint8]
+	t.sumWide = sumWide[uint64, uint8]
+	t.min = min[uint8]
+	t.max = max[uint8]
+	t.minmax = minmax[uint8]
+	t.argmin = argmin[uint8]
+	t.argmax = argmax[uint8]
+	t.dot = dot[uint8]
+	t.add = add[uint8]
+	t.sub = sub[uint8]
+	t.mul = mul[uint8]
+	t.div = div[uint8]
+	t.addScalar = addScalar[uint8]
+	t.subScalar = subScalar[uint8]
+	t.rsubScalar = rsubScalar[uint8]
+	t.mulScalar = mulScalar[uint8]
+	t.divScalar = divScalar[uint8]
+	t.rdivScalar = rdivScalar[uint8]
+	t.minOf = minOf[uint8]
+	t.maxOf = maxOf[uint8]
+	t.minOfScalar = minOfScalar[uint8]
+	t.maxOfScalar = maxOfScalar[uint8]
+	t.clamp = clamp[uint8]
+	t.equals = equals[uint8]
+	t.lessThan = lessThan[uint8]
+	t.greaterThan = greaterThan[uint8]
+	t.between = between[uint8]
+	t.compare = compare[uint8]
+	t.sumMasked = sumMasked[uint8]
+	t.minMasked = minMasked[uint8]
+	t.maxMasked = maxMasked[uint8]
+	t.compress = compress[uint8]
+	t.blend = blend[uint8]
+	t.blendScalar = blendScalar[uint8]
+	return
+}
+
 // SumUint8s sums up all of the elements of the slice and returns the value
-func SumUint8s(input []uint8) (out uint8) {
-	switch {
-	case avx2:
-		_uint8_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
-	}
+func SumUint8s(input []uint8) uint8 {
+	return tableUint8.sum(input)
 }
 
-// MinUint8s returns the smallest element value in the slice
-func MinUint8s(input []uint8) (out uint8) {
-	switch {
-	case avx2:
-		_uint8_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
-	}
+// SumUint8sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint8sToUint64(input []uint8) uint64 {
+	return tableUint8.sumWide(input)
 }
 
-// MaxUint8s returns the largest element value in the slice
-func MaxUint8s(input []uint8) (out uint8) {
-	switch {
-	case avx2:
-		_uint8_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// MinUint8s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUint8s(input []uint8) uint8 {
+	return tableUint8.min(input)
+}
+
+// MaxUint8s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUint8s(input []uint8) uint8 {
+	return tableUint8.max(input)
+}
+
+// MinMaxUint8s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint8s(input []uint8) (lo, hi uint8) {
+	return tableUint8.minmax(input)
+}
+
+// ArgMinUint8s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint8s(input []uint8) int {
+	return tableUint8.argmin(input)
+}
+
+// ArgMaxUint8s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint8s(input []uint8) int {
+	return tableUint8.argmax(input)
+}
+
+// DotUint8s computes the dot product of input1 and input2 and returns the value
+func DotUint8s(input1, input2 []uint8) uint8 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
 	}
+	return tableUint8.dot(input1[:n], input2[:n])
 }
 
 // AddUint8s adds input1 to input2 and writes back the result into dst slice
 func AddUint8s(dst, input1, input2 []uint8) []uint8 {
-	if avx2 {
-		_uint8_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return add(dst, input1, input2)
+	return tableUint8.add(dst, input1, input2)
 }
 
 // SubUint8s subtracts input2 from input1 and writes back the result into dst slice
 func SubUint8s(dst, input1, input2 []uint8) []uint8 {
-	if avx2 {
-		_uint8_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return sub(dst, input1, input2)
+	return tableUint8.sub(dst, input1, input2)
 }
 
 // MulUint8s multiplies input1 by input2 and writes back the result into dst slice
 func MulUint8s(dst, input1, input2 []uint8) []uint8 {
-	if avx2 {
-		_uint8_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint8.mul(dst, input1, input2)
 }
 
-// DivUint8s divides input1 by input2 and writes back the result into dst slice
+// DivUint8s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
 func DivUint8s(dst, input1, input2 []uint8) []uint8 {
-	if avx2 {
-		_uint8_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableUint8.div(dst, input1, input2)
 }
 
-// ---------------------------------- Uint16 ----------------------------------
-
-// SumUint16s sums up all of the elements of the slice and returns the value
-func SumUint16s(input []uint16) (out uint16) {
-	switch {
-	case avx2:
-		_uint16_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// AddScalarUint8s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.addScalar(dst, input, value)
 }
 
-// MinUint16s returns the smallest element value in the slice
-func MinUint16s(input []uint16) (out uint16) {
-	switch {
-	case avx2:
-		_uint16_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// SubScalarUint8s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.subScalar(dst, input, value)
 }
 
-// MaxUint16s returns the largest element value in the slice
-func MaxUint16s(input []uint16) (out uint16) {
-	switch {
-	case avx2:
-		_uint16_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// RevSubScalarUint8s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.rsubScalar(dst, input, value)
 }
 
-// AddUint16s adds input1 to input2 and writes back the result into dst slice
-func AddUint16s(dst, input1, input2 []uint16) []uint16 {
-	if avx2 {
-		_uint16_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MulScalarUint8s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return add(dst, input1, input2)
+	return tableUint8.mulScalar(dst, input, value)
 }
 
-// SubUint16s subtracts input2 from input1 and writes back the result into dst slice
-func SubUint16s(dst, input1, input2 []uint16) []uint16 {
-	if avx2 {
-		_uint16_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// DivScalarUint8s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return sub(dst, input1, input2)
+	return tableUint8.divScalar(dst, input, value)
 }
 
-// MulUint16s multiplies input1 by input2 and writes back the result into dst slice
-func MulUint16s(dst, input1, input2 []uint16) []uint16 {
-	if avx2 {
-		_uint16_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// RevDivScalarUint8s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint8.rdivScalar(dst, input, value)
 }
 
-// DivUint16s divides input1 by input2 and writes back the result into dst slice
-func DivUint16s(dst, input1, input2 []uint16) []uint16 {
-	if avx2 {
-		_uint16_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MinOfUint8s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint8s(dst, input1, input2 []uint8) []uint8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableUint8.minOf(dst, input1, input2)
 }
 
-// ---------------------------------- Uint32 ----------------------------------
-
-// SumUint32s sums up all of the elements of the slice and returns the value
-func SumUint32s(input []uint32) (out uint32) {
-	switch {
-	case avx2:
-		_uint32_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// MaxOfUint8s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint8s(dst, input1, input2 []uint8) []uint8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.maxOf(dst, input1, input2)
 }
 
-// MinUint32s returns the smallest element value in the slice
-func MinUint32s(input []uint32) (out uint32) {
-	switch {
-	case avx2:
-		_uint32_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// MinOfScalarUint8s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.minOfScalar(dst, input, value)
 }
 
-// MaxUint32s returns the largest element value in the slice
-func MaxUint32s(input []uint32) (out uint32) {
-	switch {
-	case avx2:
-		_uint32_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// MaxOfScalarUint8s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint8.maxOfScalar(dst, input, value)
 }
 
-// AddUint32s adds input1 to input2 and writes back the result into dst slice
-func AddUint32s(dst, input1, input2 []uint32) []uint32 {
-	if avx2 {
-		_uint32_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// ClampUint8s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint8s(dst, input []uint8, lo, hi uint8) []uint8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return add(dst, input1, input2)
+	return tableUint8.clamp(dst, input, lo, hi)
 }
 
-// SubUint32s subtracts input2 from input1 and writes back the result into dst slice
-func SubUint32s(dst, input1, input2 []uint32) []uint32 {
-	if avx2 {
-		_uint32_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// EqualsUint8s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return sub(dst, input1, input2)
+	return tableUint8.equals(input, value, bitmap)
 }
 
-// MulUint32s multiplies input1 by input2 and writes back the result into dst slice
-func MulUint32s(dst, input1, input2 []uint32) []uint32 {
-	if avx2 {
-		_uint32_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// LessThanUint8s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return mul(dst, input1, input2)
+	return tableUint8.lessThan(input, value, bitmap)
 }
 
-// DivUint32s divides input1 by input2 and writes back the result into dst slice
-func DivUint32s(dst, input1, input2 []uint32) []uint32 {
-	if avx2 {
-		_uint32_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// GreaterThanUint8s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return div(dst, input1, input2)
+	return tableUint8.greaterThan(input, value, bitmap)
 }
 
-// ---------------------------------- Uint64 ----------------------------------
+// BetweenUint8s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint8s(input []uint8, lo, hi uint8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint8.between(input, lo, hi, bitmap)
+}
 
-// SumUint64s sums up all of the elements of the slice and returns the value
-func SumUint64s(input []uint64) (out uint64) {
-	switch {
-	case avx2:
-		_uint64_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// CompareUint8s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint8s(input1, input2 []uint8, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
 	}
+	return tableUint8.compare(input1, input2, op, bitmap)
 }
 
-// MinUint64s returns the smallest element value in the slice
-func MinUint64s(input []uint64) (out uint64) {
-	switch {
-	case avx2:
-		_uint64_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// SumUint8sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint8sMasked(input []uint8, mask []uint64) uint8 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
 	}
+	return tableUint8.sumMasked(input, mask)
 }
 
-// MaxUint64s returns the largest element value in the slice
-func MaxUint64s(input []uint64) (out uint64) {
-	switch {
-	case avx2:
-		_uint64_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// MinUint8sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint8sMasked(input []uint8, mask []uint64) (uint8, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
 	}
+	return tableUint8.minMasked(input, mask)
 }
 
-// AddUint64s adds input1 to input2 and writes back the result into dst slice
-func AddUint64s(dst, input1, input2 []uint64) []uint64 {
-	if avx2 {
-		_uint64_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// MaxUint8sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint8sMasked(input []uint8, mask []uint64) (uint8, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
 	}
-	return add(dst, input1, input2)
+	return tableUint8.maxMasked(input, mask)
 }
 
-// SubUint64s subtracts input2 from input1 and writes back the result into dst slice
-func SubUint64s(dst, input1, input2 []uint64) []uint64 {
-	if avx2 {
-		_uint64_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// CompressUint8s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full. It is only vectorized
+// for uint8 on AVX-512 CPUs with VBMI2, and otherwise the elements are copied one at a time in Go.
+func CompressUint8s(dst, input []uint8, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
 	}
-	return sub(dst, input1, input2)
+	return tableUint8.compress(dst, input, mask)
 }
 
-// MulUint64s multiplies input1 by input2 and writes back the result into dst slice
-func MulUint64s(dst, input1, input2 []uint64) []uint64 {
-	if avx2 {
-		_uint64_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// SelectUint8s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint8s(dst, input1, input2 []uint8, mask []uint64) []uint8 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint8.blend(dst, input1, input2, mask)
 }
 
-// DivUint64s divides input1 by input2 and writes back the result into dst slice
-func DivUint64s(dst, input1, input2 []uint64) []uint64 {
-	if avx2 {
-		_uint64_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// SelectScalarUint8s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint8s(dst, input []uint8, mask []uint64, value uint8) []uint8 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableUint8.blendScalar(dst, input, mask, value)
 }
 
-// ---------------------------------- Int8 ----------------------------------
+// ---------------------------------- Uint16 ----------------------------------
 
-// SumInt8s sums up all of the elements of the slice and returns the value
-func SumInt8s(input []int8) (out int8) {
-	switch {
-	case avx2:
-		_int8_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
-	}
+// tableUint16 is the function table for uint16, populated by dispatch
+var tableUint16 table[uint16, uint64]
+
+// genericUint16s returns the table of generic functions for uint16
+func genericUint16s() (t table[uint16, uint64]) {
+	t.sum = sum[uint16]
+	t.sumWide = sumWide[uint64, uint16]
+	t.min = min[uint16]
+	t.max = max[uint16]
+	t.minmax = minmax[uint16]
+	t.argmin = argmin[uint16]
+	t.argmax = argmax[uint16]
+	t.dot = dot[uint16]
+	t.add = add[uint16]
+	t.sub = sub[uint16]
+	t.mul = mul[uint16]
+	t.div = div[uint16]
+	t.addScalar = addScalar[uint16]
+	t.subScalar = subScalar[uint16]
+	t.rsubScalar = rsubScalar[uint16]
+	t.mulScalar = mulScalar[uint16]
+	t.divScalar = divScalar[uint16]
+	t.rdivScalar = rdivScalar[uint16]
+	t.minOf = minOf[uint16]
+	t.maxOf = maxOf[uint16]
+	t.minOfScalar = minOfScalar[uint16]
+	t.maxOfScalar = maxOfScalar[uint16]
+	t.clamp = clamp[uint16]
+	t.equals = equals[uint16]
+	t.lessThan = lessThan[uint16]
+	t.greaterThan = greaterThan[uint16]
+	t.between = between[uint16]
+	t.compare = compare[uint16]
+	t.sumMasked = sumMasked[uint16]
+	t.minMasked = minMasked[uint16]
+	t.maxMasked = maxMasked[uint16]
+	t.compress = compress[uint16]
+	t.blend = blend[uint16]
+	t.blendScalar = blendScalar[uint16]
+	return
 }
 
-// MinInt8s returns the smallest element value in the slice
-func MinInt8s(input []int8) (out int8) {
-	switch {
-	case avx2:
-		_int8_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
-	}
+// SumUint16s sums up all of the elements of the slice and returns the value
+func SumUint16s(input []uint16) uint16 {
+	return tableUint16.sum(input)
 }
 
-// MaxInt8s returns the largest element value in the slice
-func MaxInt8s(input []int8) (out int8) {
-	switch {
-	case avx2:
-		_int8_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
-	}
+// SumUint16sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint16sToUint64(input []uint16) uint64 {
+	return tableUint16.sumWide(input)
 }
 
-// AddInt8s adds input1 to input2 and writes back the result into dst slice
-func AddInt8s(dst, input1, input2 []int8) []int8 {
-	if avx2 {
-		_int8_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return add(dst, input1, input2)
+// MinUint16s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUint16s(input []uint16) uint16 {
+	return tableUint16.min(input)
 }
 
-// SubInt8s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt8s(dst, input1, input2 []int8) []int8 {
-	if avx2 {
-		_int8_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return sub(dst, input1, input2)
+// MaxUint16s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUint16s(input []uint16) uint16 {
+	return tableUint16.max(input)
 }
 
-// MulInt8s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt8s(dst, input1, input2 []int8) []int8 {
-	if avx2 {
-		_int8_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return mul(dst, input1, input2)
+// MinMaxUint16s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint16s(input []uint16) (lo, hi uint16) {
+	return tableUint16.minmax(input)
 }
 
-// DivInt8s divides input1 by input2 and writes back the result into dst slice
-func DivInt8s(dst, input1, input2 []int8) []int8 {
-	if avx2 {
-		_int8_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return div(dst, input1, input2)
+// ArgMinUint16s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint16s(input []uint16) int {
+	return tableUint16.argmin(input)
 }
 
-// ---------------------------------- Int16 ----------------------------------
+// ArgMaxUint16s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint16s(input []uint16) int {
+	return tableUint16.argmax(input)
+}
 
-// SumInt16s sums up all of the elements of the slice and returns the value
-func SumInt16s(input []int16) (out int16) {
-	switch {
-	case avx2:
-		_int16_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// DotUint16s computes the dot product of input1 and input2 and returns the value
+func DotUint16s(input1, input2 []uint16) uint16 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
 	}
+	return tableUint16.dot(input1[:n], input2[:n])
 }
 
-// MinInt16s returns the smallest element value in the slice
-func MinInt16s(input []int16) (out int16) {
-	switch {
-	case avx2:
-		_int16_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// AddUint16s adds input1 to input2 and writes back the result into dst slice
+func AddUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.add(dst, input1, input2)
 }
 
-// MaxInt16s returns the largest element value in the slice
-func MaxInt16s(input []int16) (out int16) {
-	switch {
-	case avx2:
-		_int16_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// SubUint16s subtracts input2 from input1 and writes back the result into dst slice
+func SubUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.sub(dst, input1, input2)
 }
 
-// AddInt16s adds input1 to input2 and writes back the result into dst slice
-func AddInt16s(dst, input1, input2 []int16) []int16 {
-	if avx2 {
-		_int16_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MulUint16s multiplies input1 by input2 and writes back the result into dst slice
+func MulUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return add(dst, input1, input2)
+	return tableUint16.mul(dst, input1, input2)
 }
 
-// SubInt16s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt16s(dst, input1, input2 []int16) []int16 {
-	if avx2 {
-		_int16_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// DivUint16s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return sub(dst, input1, input2)
+	return tableUint16.div(dst, input1, input2)
 }
 
-// MulInt16s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt16s(dst, input1, input2 []int16) []int16 {
-	if avx2 {
-		_int16_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// AddScalarUint16s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint16.addScalar(dst, input, value)
 }
 
-// DivInt16s divides input1 by input2 and writes back the result into dst slice
-func DivInt16s(dst, input1, input2 []int16) []int16 {
-	if avx2 {
-		_int16_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// SubScalarUint16s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableUint16.subScalar(dst, input, value)
 }
 
-// ---------------------------------- Int32 ----------------------------------
-
-// SumInt32s sums up all of the elements of the slice and returns the value
-func SumInt32s(input []int32) (out int32) {
-	switch {
-	case avx2:
-		_int32_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// RevSubScalarUint16s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.rsubScalar(dst, input, value)
 }
 
-// MinInt32s returns the smallest element value in the slice
-func MinInt32s(input []int32) (out int32) {
-	switch {
-	case avx2:
-		_int32_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// MulScalarUint16s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.mulScalar(dst, input, value)
 }
 
-// MaxInt32s returns the largest element value in the slice
-func MaxInt32s(input []int32) (out int32) {
-	switch {
-	case avx2:
-		_int32_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// DivScalarUint16s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.divScalar(dst, input, value)
 }
 
-// AddInt32s adds input1 to input2 and writes back the result into dst slice
-func AddInt32s(dst, input1, input2 []int32) []int32 {
-	if avx2 {
-		_int32_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// RevDivScalarUint16s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return add(dst, input1, input2)
+	return tableUint16.rdivScalar(dst, input, value)
 }
 
-// SubInt32s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt32s(dst, input1, input2 []int32) []int32 {
-	if avx2 {
-		_int32_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MinOfUint16s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return sub(dst, input1, input2)
+	return tableUint16.minOf(dst, input1, input2)
 }
 
-// MulInt32s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt32s(dst, input1, input2 []int32) []int32 {
-	if avx2 {
-		_int32_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MaxOfUint16s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint16s(dst, input1, input2 []uint16) []uint16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint16.maxOf(dst, input1, input2)
 }
 
-// DivInt32s divides input1 by input2 and writes back the result into dst slice
-func DivInt32s(dst, input1, input2 []int32) []int32 {
-	if avx2 {
-		_int32_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// MinOfScalarUint16s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableUint16.minOfScalar(dst, input, value)
 }
 
-// ---------------------------------- Int64 ----------------------------------
-
-// SumInt64s sums up all of the elements of the slice and returns the value
-func SumInt64s(input []int64) (out int64) {
-	switch {
-	case avx2:
-		_int64_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// MaxOfScalarUint16s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.maxOfScalar(dst, input, value)
 }
 
-// MinInt64s returns the smallest element value in the slice
-func MinInt64s(input []int64) (out int64) {
-	switch {
-	case avx2:
-		_int64_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// ClampUint16s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint16s(dst, input []uint16, lo, hi uint16) []uint16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
 	}
+	return tableUint16.clamp(dst, input, lo, hi)
 }
 
-// MaxInt64s returns the largest element value in the slice
-func MaxInt64s(input []int64) (out int64) {
-	switch {
-	case avx2:
-		_int64_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// EqualsUint16s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
+	return tableUint16.equals(input, value, bitmap)
 }
 
-// AddInt64s adds input1 to input2 and writes back the result into dst slice
-func AddInt64s(dst, input1, input2 []int64) []int64 {
-	if avx2 {
-		_int64_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// LessThanUint16s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return add(dst, input1, input2)
+	return tableUint16.lessThan(input, value, bitmap)
 }
 
-// SubInt64s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt64s(dst, input1, input2 []int64) []int64 {
-	if avx2 {
-		_int64_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// GreaterThanUint16s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return sub(dst, input1, input2)
+	return tableUint16.greaterThan(input, value, bitmap)
 }
 
-// MulInt64s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt64s(dst, input1, input2 []int64) []int64 {
-	if avx2 {
-		_int64_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// BetweenUint16s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint16s(input []uint16, lo, hi uint16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
 	}
-	return mul(dst, input1, input2)
+	return tableUint16.between(input, lo, hi, bitmap)
 }
 
-// DivInt64s divides input1 by input2 and writes back the result into dst slice
-func DivInt64s(dst, input1, input2 []int64) []int64 {
-	if avx2 {
-		_int64_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// CompareUint16s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint16s(input1, input2 []uint16, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
 	}
-	return div(dst, input1, input2)
+	return tableUint16.compare(input1, input2, op, bitmap)
 }
 
-// ---------------------------------- Float32 ----------------------------------
-
-// SumFloat32s sums up all of the elements of the slice and returns the value
-func SumFloat32s(input []float32) (out float32) {
-	switch {
-	case avx2:
-		_float32_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
+// SumUint16sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint16sMasked(input []uint16, mask []uint64) uint16 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
 	}
+	return tableUint16.sumMasked(input, mask)
 }
 
-// MinFloat32s returns the smallest element value in the slice
-func MinFloat32s(input []float32) (out float32) {
-	switch {
-	case avx2:
-		_float32_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
+// MinUint16sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint16sMasked(input []uint16, mask []uint64) (uint16, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
 	}
+	return tableUint16.minMasked(input, mask)
 }
 
-// MaxFloat32s returns the largest element value in the slice
-func MaxFloat32s(input []float32) (out float32) {
-	switch {
-	case avx2:
-		_float32_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
+// MaxUint16sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint16sMasked(input []uint16, mask []uint64) (uint16, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
 	}
+	return tableUint16.maxMasked(input, mask)
 }
 
-// AddFloat32s adds input1 to input2 and writes back the result into dst slice
-func AddFloat32s(dst, input1, input2 []float32) []float32 {
-	if avx2 {
-		_float32_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
+// CompressUint16s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full. It is only vectorized
+// for uint16 on AVX-512 CPUs with VBMI2, and otherwise the elements are copied one at a time in Go.
+func CompressUint16s(dst, input []uint16, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
 	}
-	return add(dst, input1, input2)
+	return tableUint16.compress(dst, input, mask)
 }
 
-// SubFloat32s subtracts input2 from input1 and writes back the result into dst slice
-func SubFloat32s(dst, input1, input2 []float32) []float32 {
-	if avx2 {
-		_float32_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// SelectUint16s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint16s(dst, input1, input2 []uint16, mask []uint64) []uint16 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
 		return dst
 	}
-	return sub(dst, input1, input2)
+	return tableUint16.blend(dst, input1, input2, mask)
 }
 
-// MulFloat32s multiplies input1 by input2 and writes back the result into dst slice
-func MulFloat32s(dst, input1, input2 []float32) []float32 {
-	if avx2 {
-		_float32_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+// SelectScalarUint16s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint16s(dst, input []uint16, mask []uint64, value uint16) []uint16 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
 		return dst
 	}
-	return mul(dst, input1, input2)
+	return tableUint16.blendScalar(dst, input, mask, value)
 }
 
-// DivFloat32s divides input1 by input2 and writes back the result into dst slice
-func DivFloat32s(dst, input1, input2 []float32) []float32 {
-	if avx2 {
-		_float32_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return div(dst, input1, input2)
+// ---------------------------------- Uint32 ----------------------------------
+
+// tableUint32 is the function table for uint32, populated by dispatch
+var tableUint32 table[uint32, uint64]
+
+// genericUint32s returns the table of generic functions for uint32
+func genericUint32s() (t table[uint32, uint64]) {
+	t.sum = sum[uint32]
+	t.sumWide = sumWide[uint64, uint32]
+	t.min = min[uint32]
+	t.max = max[uint32]
+	t.minmax = minmax[uint32]
+	t.argmin = argmin[uint32]
+	t.argmax = argmax[uint32]
+	t.dot = dot[uint32]
+	t.add = add[uint32]
+	t.sub = sub[uint32]
+	t.mul = mul[uint32]
+	t.div = div[uint32]
+	t.addScalar = addScalar[uint32]
+	t.subScalar = subScalar[uint32]
+	t.rsubScalar = rsubScalar[uint32]
+	t.mulScalar = mulScalar[uint32]
+	t.divScalar = divScalar[uint32]
+	t.rdivScalar = rdivScalar[uint32]
+	t.minOf = minOf[uint32]
+	t.maxOf = maxOf[uint32]
+	t.minOfScalar = minOfScalar[uint32]
+	t.maxOfScalar = maxOfScalar[uint32]
+	t.clamp = clamp[uint32]
+	t.equals = equals[uint32]
+	t.lessThan = lessThan[uint32]
+	t.greaterThan = greaterThan[uint32]
+	t.between = between[uint32]
+	t.compare = compare[uint32]
+	t.sumMasked = sumMasked[uint32]
+	t.minMasked = minMasked[uint32]
+	t.maxMasked = maxMasked[uint32]
+	t.compress = compress[uint32]
+	t.blend = blend[uint32]
+	t.blendScalar = blendScalar[uint32]
+	return
 }
 
-// ---------------------------------- Float64 ----------------------------------
+// SumUint32s sums up all of the elements of the slice and returns the value
+func SumUint32s(input []uint32) uint32 {
+	return tableUint32.sum(input)
+}
 
-// SumFloat64s sums up all of the elements of the slice and returns the value
-func SumFloat64s(input []float64) (out float64) {
-	switch {
-	case avx2:
-		_float64_avx2_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return sum(input)
-	}
+// SumUint32sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint32sToUint64(input []uint32) uint64 {
+	return tableUint32.sumWide(input)
 }
 
-// MinFloat64s returns the smallest element value in the slice
-func MinFloat64s(input []float64) (out float64) {
-	switch {
-	case avx2:
-		_float64_avx2_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return min(input)
-	}
+// MinUint32s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUint32s(input []uint32) uint32 {
+	return tableUint32.min(input)
 }
 
-// MaxFloat64s returns the largest element value in the slice
-func MaxFloat64s(input []float64) (out float64) {
-	switch {
-	case avx2:
-		_float64_avx2_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
-		return
-	default:
-		return max(input)
-	}
+// MaxUint32s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUint32s(input []uint32) uint32 {
+	return tableUint32.max(input)
 }
 
-// AddFloat64s adds input1 to input2 and writes back the result into dst slice
-func AddFloat64s(dst, input1, input2 []float64) []float64 {
-	if avx2 {
-		_float64_avx2_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return add(dst, input1, input2)
+// MinMaxUint32s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint32s(input []uint32) (lo, hi uint32) {
+	return tableUint32.minmax(input)
 }
 
-// SubFloat64s subtracts input2 from input1 and writes back the result into dst slice
-func SubFloat64s(dst, input1, input2 []float64) []float64 {
-	if avx2 {
-		_float64_avx2_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return sub(dst, input1, input2)
+// ArgMinUint32s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint32s(input []uint32) int {
+	return tableUint32.argmin(input)
 }
 
-// MulFloat64s multiplies input1 by input2 and writes back the result into dst slice
-func MulFloat64s(dst, input1, input2 []float64) []float64 {
-	if avx2 {
-		_float64_avx2_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
-		return dst
-	}
-	return mul(dst, input1, input2)
+// ArgMaxUint32s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint32s(input []uint32) int {
+	return tableUint32.argmax(input)
+}
+
+// DotUint32s computes the dot product of input1 and input2 and returns the value
+func DotUint32s(input1, input2 []uint32) uint32 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableUint32.dot(input1[:n], input2[:n])
+}
+
+// AddUint32s adds input1 to input2 and writes back the result into dst slice
+func AddUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.add(dst, input1, input2)
+}
+
+// SubUint32s subtracts input2 from input1 and writes back the result into dst slice
+func SubUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.sub(dst, input1, input2)
+}
+
+// MulUint32s multiplies input1 by input2 and writes back the result into dst slice
+func MulUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.mul(dst, input1, input2)
+}
+
+// DivUint32s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.div(dst, input1, input2)
+}
+
+// AddScalarUint32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.addScalar(dst, input, value)
+}
+
+// SubScalarUint32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.subScalar(dst, input, value)
+}
+
+// RevSubScalarUint32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.rsubScalar(dst, input, value)
+}
+
+// MulScalarUint32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.mulScalar(dst, input, value)
+}
+
+// DivScalarUint32s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.divScalar(dst, input, value)
+}
+
+// RevDivScalarUint32s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.rdivScalar(dst, input, value)
+}
+
+// MinOfUint32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.minOf(dst, input1, input2)
+}
+
+// MaxOfUint32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint32s(dst, input1, input2 []uint32) []uint32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarUint32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarUint32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.maxOfScalar(dst, input, value)
+}
+
+// ClampUint32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint32s(dst, input []uint32, lo, hi uint32) []uint32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.clamp(dst, input, lo, hi)
+}
+
+// EqualsUint32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint32.equals(input, value, bitmap)
+}
+
+// LessThanUint32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint32.lessThan(input, value, bitmap)
+}
+
+// GreaterThanUint32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint32.greaterThan(input, value, bitmap)
+}
+
+// BetweenUint32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint32s(input []uint32, lo, hi uint32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint32.between(input, lo, hi, bitmap)
+}
+
+// CompareUint32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint32s(input1, input2 []uint32, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableUint32.compare(input1, input2, op, bitmap)
+}
+
+// SumUint32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint32sMasked(input []uint32, mask []uint64) uint32 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableUint32.sumMasked(input, mask)
+}
+
+// MinUint32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint32sMasked(input []uint32, mask []uint64) (uint32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableUint32.minMasked(input, mask)
+}
+
+// MaxUint32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint32sMasked(input []uint32, mask []uint64) (uint32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableUint32.maxMasked(input, mask)
+}
+
+// CompressUint32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint32s(dst, input []uint32, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableUint32.compress(dst, input, mask)
+}
+
+// SelectUint32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint32s(dst, input1, input2 []uint32, mask []uint64) []uint32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarUint32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint32s(dst, input []uint32, mask []uint64, value uint32) []uint32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint32.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Uint64 ----------------------------------
+
+// tableUint64 is the function table for uint64, populated by dispatch
+var tableUint64 table[uint64, uint64]
+
+// genericUint64s returns the table of generic functions for uint64
+func genericUint64s() (t table[uint64, uint64]) {
+	t.sum = sum[uint64]
+	t.min = min[uint64]
+	t.max = max[uint64]
+	t.minmax = minmax[uint64]
+	t.argmin = argmin[uint64]
+	t.argmax = argmax[uint64]
+	t.dot = dot[uint64]
+	t.add = add[uint64]
+	t.sub = sub[uint64]
+	t.mul = mul[uint64]
+	t.div = div[uint64]
+	t.addScalar = addScalar[uint64]
+	t.subScalar = subScalar[uint64]
+	t.rsubScalar = rsubScalar[uint64]
+	t.mulScalar = mulScalar[uint64]
+	t.divScalar = divScalar[uint64]
+	t.rdivScalar = rdivScalar[uint64]
+	t.minOf = minOf[uint64]
+	t.maxOf = maxOf[uint64]
+	t.minOfScalar = minOfScalar[uint64]
+	t.maxOfScalar = maxOfScalar[uint64]
+	t.clamp = clamp[uint64]
+	t.equals = equals[uint64]
+	t.lessThan = lessThan[uint64]
+	t.greaterThan = greaterThan[uint64]
+	t.between = between[uint64]
+	t.compare = compare[uint64]
+	t.sumMasked = sumMasked[uint64]
+	t.minMasked = minMasked[uint64]
+	t.maxMasked = maxMasked[uint64]
+	t.compress = compress[uint64]
+	t.blend = blend[uint64]
+	t.blendScalar = blendScalar[uint64]
+	return
+}
+
+// SumUint64s sums up all of the elements of the slice and returns the value
+func SumUint64s(input []uint64) uint64 {
+	return tableUint64.sum(input)
+}
+
+// MinUint64s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUint64s(input []uint64) uint64 {
+	return tableUint64.min(input)
+}
+
+// MaxUint64s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUint64s(input []uint64) uint64 {
+	return tableUint64.max(input)
+}
+
+// MinMaxUint64s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint64s(input []uint64) (lo, hi uint64) {
+	return tableUint64.minmax(input)
+}
+
+// ArgMinUint64s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint64s(input []uint64) int {
+	return tableUint64.argmin(input)
+}
+
+// ArgMaxUint64s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint64s(input []uint64) int {
+	return tableUint64.argmax(input)
+}
+
+// DotUint64s computes the dot product of input1 and input2 and returns the value
+func DotUint64s(input1, input2 []uint64) uint64 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableUint64.dot(input1[:n], input2[:n])
+}
+
+// AddUint64s adds input1 to input2 and writes back the result into dst slice
+func AddUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.add(dst, input1, input2)
+}
+
+// SubUint64s subtracts input2 from input1 and writes back the result into dst slice
+func SubUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.sub(dst, input1, input2)
+}
+
+// MulUint64s multiplies input1 by input2 and writes back the result into dst slice
+func MulUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.mul(dst, input1, input2)
+}
+
+// DivUint64s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.div(dst, input1, input2)
+}
+
+// AddScalarUint64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.addScalar(dst, input, value)
+}
+
+// SubScalarUint64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.subScalar(dst, input, value)
+}
+
+// RevSubScalarUint64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.rsubScalar(dst, input, value)
+}
+
+// MulScalarUint64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.mulScalar(dst, input, value)
+}
+
+// DivScalarUint64s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.divScalar(dst, input, value)
+}
+
+// RevDivScalarUint64s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.rdivScalar(dst, input, value)
+}
+
+// MinOfUint64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.minOf(dst, input1, input2)
+}
+
+// MaxOfUint64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint64s(dst, input1, input2 []uint64) []uint64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarUint64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarUint64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.maxOfScalar(dst, input, value)
+}
+
+// ClampUint64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint64s(dst, input []uint64, lo, hi uint64) []uint64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.clamp(dst, input, lo, hi)
+}
+
+// EqualsUint64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint64.equals(input, value, bitmap)
+}
+
+// LessThanUint64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint64.lessThan(input, value, bitmap)
+}
+
+// GreaterThanUint64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint64.greaterThan(input, value, bitmap)
+}
+
+// BetweenUint64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint64s(input []uint64, lo, hi uint64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableUint64.between(input, lo, hi, bitmap)
+}
+
+// CompareUint64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint64s(input1, input2 []uint64, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableUint64.compare(input1, input2, op, bitmap)
+}
+
+// SumUint64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint64sMasked(input []uint64, mask []uint64) uint64 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableUint64.sumMasked(input, mask)
+}
+
+// MinUint64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint64sMasked(input []uint64, mask []uint64) (uint64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableUint64.minMasked(input, mask)
+}
+
+// MaxUint64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint64sMasked(input []uint64, mask []uint64) (uint64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableUint64.maxMasked(input, mask)
+}
+
+// CompressUint64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint64s(dst, input []uint64, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableUint64.compress(dst, input, mask)
+}
+
+// SelectUint64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint64s(dst, input1, input2 []uint64, mask []uint64) []uint64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarUint64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint64s(dst, input []uint64, mask []uint64, value uint64) []uint64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableUint64.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int8 ----------------------------------
+
+// tableInt8 is the function table for int8, populated by dispatch
+var tableInt8 table[int8, int64]
+
+// genericInt8s returns the table of generic functions for int8
+func genericInt8s() (t table[int8, int64]) {
+	t.sum = sum[int8]
+	t.sumWide = sumWide[int64, int8]
+	t.min = min[int8]
+	t.max = max[int8]
+	t.minmax = minmax[int8]
+	t.argmin = argmin[int8]
+	t.argmax = argmax[int8]
+	t.dot = dot[int8]
+	t.add = add[int8]
+	t.sub = sub[int8]
+	t.mul = mul[int8]
+	t.div = div[int8]
+	t.addScalar = addScalar[int8]
+	t.subScalar = subScalar[int8]
+	t.rsubScalar = rsubScalar[int8]
+	t.mulScalar = mulScalar[int8]
+	t.divScalar = divScalar[int8]
+	t.rdivScalar = rdivScalar[int8]
+	t.minOf = minOf[int8]
+	t.maxOf = maxOf[int8]
+	t.minOfScalar = minOfScalar[int8]
+	t.maxOfScalar = maxOfScalar[int8]
+	t.clamp = clamp[int8]
+	t.equals = equals[int8]
+	t.lessThan = lessThan[int8]
+	t.greaterThan = greaterThan[int8]
+	t.between = between[int8]
+	t.compare = compare[int8]
+	t.sumMasked = sumMasked[int8]
+	t.minMasked = minMasked[int8]
+	t.maxMasked = maxMasked[int8]
+	t.compress = compress[int8]
+	t.blend = blend[int8]
+	t.blendScalar = blendScalar[int8]
+	return
+}
+
+// SumInt8s sums up all of the elements of the slice and returns the value
+func SumInt8s(input []int8) int8 {
+	return tableInt8.sum(input)
+}
+
+// SumInt8sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt8sToInt64(input []int8) int64 {
+	return tableInt8.sumWide(input)
+}
+
+// MinInt8s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt8s(input []int8) int8 {
+	return tableInt8.min(input)
+}
+
+// MaxInt8s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt8s(input []int8) int8 {
+	return tableInt8.max(input)
+}
+
+// MinMaxInt8s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt8s(input []int8) (lo, hi int8) {
+	return tableInt8.minmax(input)
+}
+
+// ArgMinInt8s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt8s(input []int8) int {
+	return tableInt8.argmin(input)
+}
+
+// ArgMaxInt8s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt8s(input []int8) int {
+	return tableInt8.argmax(input)
+}
+
+// DotInt8s computes the dot product of input1 and input2 and returns the value
+func DotInt8s(input1, input2 []int8) int8 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableInt8.dot(input1[:n], input2[:n])
+}
+
+// AddInt8s adds input1 to input2 and writes back the result into dst slice
+func AddInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.add(dst, input1, input2)
+}
+
+// SubInt8s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.sub(dst, input1, input2)
+}
+
+// MulInt8s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.mul(dst, input1, input2)
+}
+
+// DivInt8s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.div(dst, input1, input2)
+}
+
+// AddScalarInt8s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.addScalar(dst, input, value)
+}
+
+// SubScalarInt8s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.subScalar(dst, input, value)
+}
+
+// RevSubScalarInt8s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.rsubScalar(dst, input, value)
+}
+
+// MulScalarInt8s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.mulScalar(dst, input, value)
+}
+
+// DivScalarInt8s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.divScalar(dst, input, value)
+}
+
+// RevDivScalarInt8s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.rdivScalar(dst, input, value)
+}
+
+// MinOfInt8s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.minOf(dst, input1, input2)
+}
+
+// MaxOfInt8s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt8s(dst, input1, input2 []int8) []int8 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt8s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt8s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt8s(dst, input []int8, value int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.maxOfScalar(dst, input, value)
+}
+
+// ClampInt8s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt8s(dst, input []int8, lo, hi int8) []int8 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.clamp(dst, input, lo, hi)
+}
+
+// EqualsInt8s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt8.equals(input, value, bitmap)
+}
+
+// LessThanInt8s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt8.lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt8s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt8.greaterThan(input, value, bitmap)
+}
+
+// BetweenInt8s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt8s(input []int8, lo, hi int8, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt8.between(input, lo, hi, bitmap)
+}
+
+// CompareInt8s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt8s(input1, input2 []int8, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableInt8.compare(input1, input2, op, bitmap)
+}
+
+// SumInt8sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt8sMasked(input []int8, mask []uint64) int8 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableInt8.sumMasked(input, mask)
+}
+
+// MinInt8sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt8sMasked(input []int8, mask []uint64) (int8, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt8.minMasked(input, mask)
+}
+
+// MaxInt8sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt8sMasked(input []int8, mask []uint64) (int8, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt8.maxMasked(input, mask)
+}
+
+// CompressInt8s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full. It is only vectorized
+// for int8 on AVX-512 CPUs with VBMI2, and otherwise the elements are copied one at a time in Go.
+func CompressInt8s(dst, input []int8, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableInt8.compress(dst, input, mask)
+}
+
+// SelectInt8s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt8s(dst, input1, input2 []int8, mask []uint64) []int8 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt8s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt8s(dst, input []int8, mask []uint64, value int8) []int8 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt8.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int16 ----------------------------------
+
+// tableInt16 is the function table for int16, populated by dispatch
+var tableInt16 table[int16, int64]
+
+// genericInt16s returns the table of generic functions for int16
+func genericInt16s() (t table[int16, int64]) {
+	t.sum = sum[int16]
+	t.sumWide = sumWide[int64, int16]
+	t.min = min[int16]
+	t.max = max[int16]
+	t.minmax = minmax[int16]
+	t.argmin = argmin[int16]
+	t.argmax = argmax[int16]
+	t.dot = dot[int16]
+	t.add = add[int16]
+	t.sub = sub[int16]
+	t.mul = mul[int16]
+	t.div = div[int16]
+	t.addScalar = addScalar[int16]
+	t.subScalar = subScalar[int16]
+	t.rsubScalar = rsubScalar[int16]
+	t.mulScalar = mulScalar[int16]
+	t.divScalar = divScalar[int16]
+	t.rdivScalar = rdivScalar[int16]
+	t.minOf = minOf[int16]
+	t.maxOf = maxOf[int16]
+	t.minOfScalar = minOfScalar[int16]
+	t.maxOfScalar = maxOfScalar[int16]
+	t.clamp = clamp[int16]
+	t.equals = equals[int16]
+	t.lessThan = lessThan[int16]
+	t.greaterThan = greaterThan[int16]
+	t.between = between[int16]
+	t.compare = compare[int16]
+	t.sumMasked = sumMasked[int16]
+	t.minMasked = minMasked[int16]
+	t.maxMasked = maxMasked[int16]
+	t.compress = compress[int16]
+	t.blend = blend[int16]
+	t.blendScalar = blendScalar[int16]
+	return
+}
+
+// SumInt16s sums up all of the elements of the slice and returns the value
+func SumInt16s(input []int16) int16 {
+	return tableInt16.sum(input)
+}
+
+// SumInt16sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt16sToInt64(input []int16) int64 {
+	return tableInt16.sumWide(input)
+}
+
+// MinInt16s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt16s(input []int16) int16 {
+	return tableInt16.min(input)
+}
+
+// MaxInt16s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt16s(input []int16) int16 {
+	return tableInt16.max(input)
+}
+
+// MinMaxInt16s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt16s(input []int16) (lo, hi int16) {
+	return tableInt16.minmax(input)
+}
+
+// ArgMinInt16s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt16s(input []int16) int {
+	return tableInt16.argmin(input)
+}
+
+// ArgMaxInt16s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt16s(input []int16) int {
+	return tableInt16.argmax(input)
+}
+
+// DotInt16s computes the dot product of input1 and input2 and returns the value
+func DotInt16s(input1, input2 []int16) int16 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableInt16.dot(input1[:n], input2[:n])
+}
+
+// AddInt16s adds input1 to input2 and writes back the result into dst slice
+func AddInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.add(dst, input1, input2)
+}
+
+// SubInt16s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.sub(dst, input1, input2)
+}
+
+// MulInt16s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.mul(dst, input1, input2)
+}
+
+// DivInt16s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.div(dst, input1, input2)
+}
+
+// AddScalarInt16s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.addScalar(dst, input, value)
+}
+
+// SubScalarInt16s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.subScalar(dst, input, value)
+}
+
+// RevSubScalarInt16s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.rsubScalar(dst, input, value)
+}
+
+// MulScalarInt16s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.mulScalar(dst, input, value)
+}
+
+// DivScalarInt16s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.divScalar(dst, input, value)
+}
+
+// RevDivScalarInt16s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.rdivScalar(dst, input, value)
+}
+
+// MinOfInt16s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.minOf(dst, input1, input2)
+}
+
+// MaxOfInt16s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt16s(dst, input1, input2 []int16) []int16 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt16s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt16s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt16s(dst, input []int16, value int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.maxOfScalar(dst, input, value)
+}
+
+// ClampInt16s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt16s(dst, input []int16, lo, hi int16) []int16 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.clamp(dst, input, lo, hi)
+}
+
+// EqualsInt16s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt16.equals(input, value, bitmap)
+}
+
+// LessThanInt16s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt16.lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt16s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt16.greaterThan(input, value, bitmap)
+}
+
+// BetweenInt16s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt16s(input []int16, lo, hi int16, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt16.between(input, lo, hi, bitmap)
+}
+
+// CompareInt16s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt16s(input1, input2 []int16, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableInt16.compare(input1, input2, op, bitmap)
+}
+
+// SumInt16sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt16sMasked(input []int16, mask []uint64) int16 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableInt16.sumMasked(input, mask)
+}
+
+// MinInt16sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt16sMasked(input []int16, mask []uint64) (int16, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt16.minMasked(input, mask)
+}
+
+// MaxInt16sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt16sMasked(input []int16, mask []uint64) (int16, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt16.maxMasked(input, mask)
+}
+
+// CompressInt16s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full. It is only vectorized
+// for int16 on AVX-512 CPUs with VBMI2, and otherwise the elements are copied one at a time in Go.
+func CompressInt16s(dst, input []int16, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableInt16.compress(dst, input, mask)
+}
+
+// SelectInt16s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt16s(dst, input1, input2 []int16, mask []uint64) []int16 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt16s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt16s(dst, input []int16, mask []uint64, value int16) []int16 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt16.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int32 ----------------------------------
+
+// tableInt32 is the function table for int32, populated by dispatch
+var tableInt32 table[int32, int64]
+
+// genericInt32s returns the table of generic functions for int32
+func genericInt32s() (t table[int32, int64]) {
+	t.sum = sum[int32]
+	t.sumWide = sumWide[int64, int32]
+	t.min = min[int32]
+	t.max = max[int32]
+	t.minmax = minmax[int32]
+	t.argmin = argmin[int32]
+	t.argmax = argmax[int32]
+	t.dot = dot[int32]
+	t.add = add[int32]
+	t.sub = sub[int32]
+	t.mul = mul[int32]
+	t.div = div[int32]
+	t.addScalar = addScalar[int32]
+	t.subScalar = subScalar[int32]
+	t.rsubScalar = rsubScalar[int32]
+	t.mulScalar = mulScalar[int32]
+	t.divScalar = divScalar[int32]
+	t.rdivScalar = rdivScalar[int32]
+	t.minOf = minOf[int32]
+	t.maxOf = maxOf[int32]
+	t.minOfScalar = minOfScalar[int32]
+	t.maxOfScalar = maxOfScalar[int32]
+	t.clamp = clamp[int32]
+	t.equals = equals[int32]
+	t.lessThan = lessThan[int32]
+	t.greaterThan = greaterThan[int32]
+	t.between = between[int32]
+	t.compare = compare[int32]
+	t.sumMasked = sumMasked[int32]
+	t.minMasked = minMasked[int32]
+	t.maxMasked = maxMasked[int32]
+	t.compress = compress[int32]
+	t.blend = blend[int32]
+	t.blendScalar = blendScalar[int32]
+	return
+}
+
+// SumInt32s sums up all of the elements of the slice and returns the value
+func SumInt32s(input []int32) int32 {
+	return tableInt32.sum(input)
+}
+
+// SumInt32sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt32sToInt64(input []int32) int64 {
+	return tableInt32.sumWide(input)
+}
+
+// MinInt32s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt32s(input []int32) int32 {
+	return tableInt32.min(input)
+}
+
+// MaxInt32s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt32s(input []int32) int32 {
+	return tableInt32.max(input)
+}
+
+// MinMaxInt32s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt32s(input []int32) (lo, hi int32) {
+	return tableInt32.minmax(input)
+}
+
+// ArgMinInt32s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt32s(input []int32) int {
+	return tableInt32.argmin(input)
+}
+
+// ArgMaxInt32s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt32s(input []int32) int {
+	return tableInt32.argmax(input)
+}
+
+// DotInt32s computes the dot product of input1 and input2 and returns the value
+func DotInt32s(input1, input2 []int32) int32 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableInt32.dot(input1[:n], input2[:n])
+}
+
+// AddInt32s adds input1 to input2 and writes back the result into dst slice
+func AddInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.add(dst, input1, input2)
+}
+
+// SubInt32s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.sub(dst, input1, input2)
+}
+
+// MulInt32s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.mul(dst, input1, input2)
+}
+
+// DivInt32s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.div(dst, input1, input2)
+}
+
+// AddScalarInt32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.addScalar(dst, input, value)
+}
+
+// SubScalarInt32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.subScalar(dst, input, value)
+}
+
+// RevSubScalarInt32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.rsubScalar(dst, input, value)
+}
+
+// MulScalarInt32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.mulScalar(dst, input, value)
+}
+
+// DivScalarInt32s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.divScalar(dst, input, value)
+}
+
+// RevDivScalarInt32s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.rdivScalar(dst, input, value)
+}
+
+// MinOfInt32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.minOf(dst, input1, input2)
+}
+
+// MaxOfInt32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt32s(dst, input1, input2 []int32) []int32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt32s(dst, input []int32, value int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.maxOfScalar(dst, input, value)
+}
+
+// ClampInt32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt32s(dst, input []int32, lo, hi int32) []int32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.clamp(dst, input, lo, hi)
+}
+
+// EqualsInt32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt32.equals(input, value, bitmap)
+}
+
+// LessThanInt32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt32.lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt32.greaterThan(input, value, bitmap)
+}
+
+// BetweenInt32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt32s(input []int32, lo, hi int32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt32.between(input, lo, hi, bitmap)
+}
+
+// CompareInt32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt32s(input1, input2 []int32, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableInt32.compare(input1, input2, op, bitmap)
+}
+
+// SumInt32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt32sMasked(input []int32, mask []uint64) int32 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableInt32.sumMasked(input, mask)
+}
+
+// MinInt32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt32sMasked(input []int32, mask []uint64) (int32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt32.minMasked(input, mask)
+}
+
+// MaxInt32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt32sMasked(input []int32, mask []uint64) (int32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt32.maxMasked(input, mask)
+}
+
+// CompressInt32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt32s(dst, input []int32, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableInt32.compress(dst, input, mask)
+}
+
+// SelectInt32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt32s(dst, input1, input2 []int32, mask []uint64) []int32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt32s(dst, input []int32, mask []uint64, value int32) []int32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt32.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int64 ----------------------------------
+
+// tableInt64 is the function table for int64, populated by dispatch
+var tableInt64 table[int64, int64]
+
+// genericInt64s returns the table of generic functions for int64
+func genericInt64s() (t table[int64, int64]) {
+	t.sum = sum[int64]
+	t.min = min[int64]
+	t.max = max[int64]
+	t.minmax = minmax[int64]
+	t.argmin = argmin[int64]
+	t.argmax = argmax[int64]
+	t.dot = dot[int64]
+	t.add = add[int64]
+	t.sub = sub[int64]
+	t.mul = mul[int64]
+	t.div = div[int64]
+	t.addScalar = addScalar[int64]
+	t.subScalar = subScalar[int64]
+	t.rsubScalar = rsubScalar[int64]
+	t.mulScalar = mulScalar[int64]
+	t.divScalar = divScalar[int64]
+	t.rdivScalar = rdivScalar[int64]
+	t.minOf = minOf[int64]
+	t.maxOf = maxOf[int64]
+	t.minOfScalar = minOfScalar[int64]
+	t.maxOfScalar = maxOfScalar[int64]
+	t.clamp = clamp[int64]
+	t.equals = equals[int64]
+	t.lessThan = lessThan[int64]
+	t.greaterThan = greaterThan[int64]
+	t.between = between[int64]
+	t.compare = compare[int64]
+	t.sumMasked = sumMasked[int64]
+	t.minMasked = minMasked[int64]
+	t.maxMasked = maxMasked[int64]
+	t.compress = compress[int64]
+	t.blend = blend[int64]
+	t.blendScalar = blendScalar[int64]
+	return
+}
+
+// SumInt64s sums up all of the elements of the slice and returns the value
+func SumInt64s(input []int64) int64 {
+	return tableInt64.sum(input)
+}
+
+// MinInt64s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt64s(input []int64) int64 {
+	return tableInt64.min(input)
+}
+
+// MaxInt64s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt64s(input []int64) int64 {
+	return tableInt64.max(input)
+}
+
+// MinMaxInt64s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt64s(input []int64) (lo, hi int64) {
+	return tableInt64.minmax(input)
+}
+
+// ArgMinInt64s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt64s(input []int64) int {
+	return tableInt64.argmin(input)
+}
+
+// ArgMaxInt64s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt64s(input []int64) int {
+	return tableInt64.argmax(input)
+}
+
+// DotInt64s computes the dot product of input1 and input2 and returns the value
+func DotInt64s(input1, input2 []int64) int64 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableInt64.dot(input1[:n], input2[:n])
+}
+
+// AddInt64s adds input1 to input2 and writes back the result into dst slice
+func AddInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.add(dst, input1, input2)
+}
+
+// SubInt64s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.sub(dst, input1, input2)
+}
+
+// MulInt64s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.mul(dst, input1, input2)
+}
+
+// DivInt64s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.div(dst, input1, input2)
+}
+
+// AddScalarInt64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.addScalar(dst, input, value)
+}
+
+// SubScalarInt64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.subScalar(dst, input, value)
+}
+
+// RevSubScalarInt64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.rsubScalar(dst, input, value)
+}
+
+// MulScalarInt64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.mulScalar(dst, input, value)
+}
+
+// DivScalarInt64s divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.divScalar(dst, input, value)
+}
+
+// RevDivScalarInt64s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.rdivScalar(dst, input, value)
+}
+
+// MinOfInt64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.minOf(dst, input1, input2)
+}
+
+// MaxOfInt64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt64s(dst, input1, input2 []int64) []int64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt64s(dst, input []int64, value int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.maxOfScalar(dst, input, value)
+}
+
+// ClampInt64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt64s(dst, input []int64, lo, hi int64) []int64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.clamp(dst, input, lo, hi)
+}
+
+// EqualsInt64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt64.equals(input, value, bitmap)
+}
+
+// LessThanInt64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt64.lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt64.greaterThan(input, value, bitmap)
+}
+
+// BetweenInt64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt64s(input []int64, lo, hi int64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableInt64.between(input, lo, hi, bitmap)
+}
+
+// CompareInt64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt64s(input1, input2 []int64, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableInt64.compare(input1, input2, op, bitmap)
+}
+
+// SumInt64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt64sMasked(input []int64, mask []uint64) int64 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableInt64.sumMasked(input, mask)
+}
+
+// MinInt64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt64sMasked(input []int64, mask []uint64) (int64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt64.minMasked(input, mask)
+}
+
+// MaxInt64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt64sMasked(input []int64, mask []uint64) (int64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableInt64.maxMasked(input, mask)
+}
+
+// CompressInt64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt64s(dst, input []int64, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableInt64.compress(dst, input, mask)
+}
+
+// SelectInt64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt64s(dst, input1, input2 []int64, mask []uint64) []int64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt64s(dst, input []int64, mask []uint64, value int64) []int64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableInt64.blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Float32 ----------------------------------
+
+// tableFloat32 is the function table for float32, populated by dispatch
+var tableFloat32 table[float32, float64]
+
+// genericFloat32s returns the table of generic functions for float32
+func genericFloat32s() (t table[float32, float64]) {
+	t.sum = sum[float32]
+	t.sumWide = sumWide[float64, float32]
+	t.min = min[float32]
+	t.max = max[float32]
+	t.nanmin = nanmin[float32]
+	t.nanmax = nanmax[float32]
+	t.sumKahan = sumKahan[float32]
+	t.sumPairwise = sumPairwise[float32]
+	t.minmax = minmax[float32]
+	t.argmin = argmin[float32]
+	t.argmax = argmax[float32]
+	t.dot = dot[float32]
+	t.add = add[float32]
+	t.sub = sub[float32]
+	t.mul = mul[float32]
+	t.div = div[float32]
+	t.addScalar = addScalar[float32]
+	t.subScalar = subScalar[float32]
+	t.rsubScalar = rsubScalar[float32]
+	t.mulScalar = mulScalar[float32]
+	t.divScalar = divScalar[float32]
+	t.rdivScalar = rdivScalar[float32]
+	t.minOf = minOf[float32]
+	t.maxOf = maxOf[float32]
+	t.minOfScalar = minOfScalar[float32]
+	t.maxOfScalar = maxOfScalar[float32]
+	t.clamp = clamp[float32]
+	t.equals = equals[float32]
+	t.lessThan = lessThan[float32]
+	t.greaterThan = greaterThan[float32]
+	t.between = between[float32]
+	t.compare = compare[float32]
+	t.sumMasked = sumMasked[float32]
+	t.minMasked = minMasked[float32]
+	t.maxMasked = maxMasked[float32]
+	t.compress = compress[float32]
+	t.blend = blend[float32]
+	t.blendScalar = blendScalar[float32]
+	t.fma = fmadd[float32]
+	t.axpy = axpy[float32]
+	return
+}
+
+// SumFloat32s sums up all of the elements of the slice and returns the value
+func SumFloat32s(input []float32) float32 {
+	return tableFloat32.sum(input)
+}
+
+// SumFloat32sToFloat64 sums up all of the elements of the slice into a float64 accumulator and returns the value
+func SumFloat32sToFloat64(input []float32) float64 {
+	return tableFloat32.sumWide(input)
+}
+
+// MinFloat32s returns the smallest element value in the slice. The -0 is smaller
+// than +0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MinFloat32s(input []float32) float32 {
+	return tableFloat32.min(input)
+}
+
+// MaxFloat32s returns the largest element value in the slice. The +0 is larger
+// than -0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MaxFloat32s(input []float32) float32 {
+	return tableFloat32.max(input)
+}
+
+// SumFloat32sKahan sums up all of the elements of the slice using compensated (Kahan) summation
+// and returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat32sKahan(input []float32) float32 {
+	return tableFloat32.sumKahan(input)
+}
+
+// SumFloat32sPairwise sums up all of the elements of the slice using pairwise summation and
+// returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat32sPairwise(input []float32) float32 {
+	return tableFloat32.sumPairwise(input)
+}
+
+// NanMinFloat32s returns the smallest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMinFloat32s(input []float32) float32 {
+	return tableFloat32.nanmin(input)
+}
+
+// NanMaxFloat32s returns the largest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMaxFloat32s(input []float32) float32 {
+	return tableFloat32.nanmax(input)
+}
+
+// MinMaxFloat32s returns both the smallest and the largest element values in the slice.
+// If any of the elements is NaN, both are NaN. It panics if the slice is empty.
+func MinMaxFloat32s(input []float32) (lo, hi float32) {
+	return tableFloat32.minmax(input)
+}
+
+// ArgMinFloat32s returns the index of the smallest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMinFloat32s(input []float32) int {
+	return tableFloat32.argmin(input)
+}
+
+// ArgMaxFloat32s returns the index of the largest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMaxFloat32s(input []float32) int {
+	return tableFloat32.argmax(input)
+}
+
+// DotFloat32s computes the dot product of input1 and input2 and returns the value
+func DotFloat32s(input1, input2 []float32) float32 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableFloat32.dot(input1[:n], input2[:n])
+}
+
+// AddFloat32s adds input1 to input2 and writes back the result into dst slice
+func AddFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.add(dst, input1, input2)
+}
+
+// SubFloat32s subtracts input2 from input1 and writes back the result into dst slice
+func SubFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.sub(dst, input1, input2)
+}
+
+// MulFloat32s multiplies input1 by input2 and writes back the result into dst slice
+func MulFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.mul(dst, input1, input2)
+}
+
+// DivFloat32s divides input1 by input2 and writes back the result into dst slice
+func DivFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.div(dst, input1, input2)
+}
+
+// AddScalarFloat32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.addScalar(dst, input, value)
+}
+
+// SubScalarFloat32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.subScalar(dst, input, value)
+}
+
+// RevSubScalarFloat32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.rsubScalar(dst, input, value)
+}
+
+// MulScalarFloat32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.mulScalar(dst, input, value)
+}
+
+// DivScalarFloat32s divides each element of the input by value and writes back the result into dst slice
+func DivScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.divScalar(dst, input, value)
+}
+
+// RevDivScalarFloat32s divides value by each element of the input and writes back the result into dst slice
+func RevDivScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.rdivScalar(dst, input, value)
+}
+
+// MinOfFloat32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MinOfFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.minOf(dst, input1, input2)
+}
+
+// MaxOfFloat32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MaxOfFloat32s(dst, input1, input2 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarFloat32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above. If either the element or value is NaN, the result is NaN.
+func MinOfScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarFloat32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below. If either the element or value is NaN, the result is NaN.
+func MaxOfScalarFloat32s(dst, input []float32, value float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.maxOfScalar(dst, input, value)
+}
+
+// ClampFloat32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi. If either the element or a bound is NaN, the result is NaN.
+func ClampFloat32s(dst, input []float32, lo, hi float32) []float32 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.clamp(dst, input, lo, hi)
+}
+
+// EqualsFloat32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat32.equals(input, value, bitmap)
+}
+
+// LessThanFloat32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat32.lessThan(input, value, bitmap)
+}
+
+// GreaterThanFloat32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat32.greaterThan(input, value, bitmap)
+}
+
+// BetweenFloat32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenFloat32s(input []float32, lo, hi float32, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat32.between(input, lo, hi, bitmap)
+}
+
+// CompareFloat32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareFloat32s(input1, input2 []float32, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableFloat32.compare(input1, input2, op, bitmap)
+}
+
+// SumFloat32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumFloat32sMasked(input []float32, mask []uint64) float32 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableFloat32.sumMasked(input, mask)
+}
+
+// MinFloat32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MinFloat32sMasked(input []float32, mask []uint64) (float32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableFloat32.minMasked(input, mask)
+}
+
+// MaxFloat32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MaxFloat32sMasked(input []float32, mask []uint64) (float32, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableFloat32.maxMasked(input, mask)
+}
+
+// CompressFloat32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressFloat32s(dst, input []float32, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableFloat32.compress(dst, input, mask)
+}
+
+// SelectFloat32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectFloat32s(dst, input1, input2 []float32, mask []uint64) []float32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarFloat32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarFloat32s(dst, input []float32, mask []uint64, value float32) []float32 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.blendScalar(dst, input, mask, value)
+}
+
+// FMAFloat32s multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func FMAFloat32s(dst, input1, input2, input3 []float32) []float32 {
+	dst = dst[:shortest(dst, input1, input2, input3)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.fma(dst, input1, input2, input3)
+}
+
+// AXPYFloat32s multiplies x by alpha, adds y and writes back the result into dst slice
+func AXPYFloat32s(dst []float32, alpha float32, x, y []float32) []float32 {
+	dst = dst[:shortest(dst, x, y)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat32.axpy(dst, alpha, x, y)
+}
+
+// ---------------------------------- Float64 ----------------------------------
+
+// tableFloat64 is the function table for float64, populated by dispatch
+var tableFloat64 table[float64, float64]
+
+// genericFloat64s returns the table of generic functions for float64
+func genericFloat64s() (t table[float64, float64]) {
+	t.sum = sum[float64]
+	t.min = min[float64]
+	t.max = max[float64]
+	t.nanmin = nanmin[float64]
+	t.nanmax = nanmax[float64]
+	t.sumKahan = sumKahan[float64]
+	t.sumPairwise = sumPairwise[float64]
+	t.minmax = minmax[float64]
+	t.argmin = argmin[float64]
+	t.argmax = argmax[float64]
+	t.dot = dot[float64]
+	t.add = add[float64]
+	t.sub = sub[float64]
+	t.mul = mul[float64]
+	t.div = div[float64]
+	t.addScalar = addScalar[float64]
+	t.subScalar = subScalar[float64]
+	t.rsubScalar = rsubScalar[float64]
+	t.mulScalar = mulScalar[float64]
+	t.divScalar = divScalar[float64]
+	t.rdivScalar = rdivScalar[float64]
+	t.minOf = minOf[float64]
+	t.maxOf = maxOf[float64]
+	t.minOfScalar = minOfScalar[float64]
+	t.maxOfScalar = maxOfScalar[float64]
+	t.clamp = clamp[float64]
+	t.equals = equals[float64]
+	t.lessThan = lessThan[float64]
+	t.greaterThan = greaterThan[float64]
+	t.between = between[float64]
+	t.compare = compare[float64]
+	t.sumMasked = sumMasked[float64]
+	t.minMasked = minMasked[float64]
+	t.maxMasked = maxMasked[float64]
+	t.compress = compress[float64]
+	t.blend = blend[float64]
+	t.blendScalar = blendScalar[float64]
+	t.fma = fmadd[float64]
+	t.axpy = axpy[float64]
+	return
+}
+
+// SumFloat64s sums up all of the elements of the slice and returns the value
+func SumFloat64s(input []float64) float64 {
+	return tableFloat64.sum(input)
+}
+
+// MinFloat64s returns the smallest element value in the slice. The -0 is smaller
+// than +0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MinFloat64s(input []float64) float64 {
+	return tableFloat64.min(input)
+}
+
+// MaxFloat64s returns the largest element value in the slice. The +0 is larger
+// than -0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MaxFloat64s(input []float64) float64 {
+	return tableFloat64.max(input)
+}
+
+// SumFloat64sKahan sums up all of the elements of the slice using compensated (Kahan) summation
+// and returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat64sKahan(input []float64) float64 {
+	return tableFloat64.sumKahan(input)
+}
+
+// SumFloat64sPairwise sums up all of the elements of the slice using pairwise summation and
+// returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat64sPairwise(input []float64) float64 {
+	return tableFloat64.sumPairwise(input)
+}
+
+// NanMinFloat64s returns the smallest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMinFloat64s(input []float64) float64 {
+	return tableFloat64.nanmin(input)
+}
+
+// NanMaxFloat64s returns the largest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMaxFloat64s(input []float64) float64 {
+	return tableFloat64.nanmax(input)
+}
+
+// MinMaxFloat64s returns both the smallest and the largest element values in the slice.
+// If any of the elements is NaN, both are NaN. It panics if the slice is empty.
+func MinMaxFloat64s(input []float64) (lo, hi float64) {
+	return tableFloat64.minmax(input)
+}
+
+// ArgMinFloat64s returns the index of the smallest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMinFloat64s(input []float64) int {
+	return tableFloat64.argmin(input)
+}
+
+// ArgMaxFloat64s returns the index of the largest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMaxFloat64s(input []float64) int {
+	return tableFloat64.argmax(input)
+}
+
+// DotFloat64s computes the dot product of input1 and input2 and returns the value
+func DotFloat64s(input1, input2 []float64) float64 {
+	n := shortest(input1, input2)
+	if n == 0 {
+		return 0
+	}
+	return tableFloat64.dot(input1[:n], input2[:n])
+}
+
+// AddFloat64s adds input1 to input2 and writes back the result into dst slice
+func AddFloat64s(dst, input1, input2 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.add(dst, input1, input2)
+}
+
+// SubFloat64s subtracts input2 from input1 and writes back the result into dst slice
+func SubFloat64s(dst, input1, input2 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.sub(dst, input1, input2)
+}
+
+// MulFloat64s multiplies input1 by input2 and writes back the result into dst slice
+func MulFloat64s(dst, input1, input2 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.mul(dst, input1, input2)
 }
 
 // DivFloat64s divides input1 by input2 and writes back the result into dst slice
 func DivFloat64s(dst, input1, input2 []float64) []float64 {
-	if avx2 {
-		_float64_avx2_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&(dst)[0]), uint64(len(dst)))
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.div(dst, input1, input2)
+}
+
+// AddScalarFloat64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.addScalar(dst, input, value)
+}
+
+// SubScalarFloat64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.subScalar(dst, input, value)
+}
+
+// RevSubScalarFloat64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.rsubScalar(dst, input, value)
+}
+
+// MulScalarFloat64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.mulScalar(dst, input, value)
+}
+
+// DivScalarFloat64s divides each element of the input by value and writes back the result into dst slice
+func DivScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.divScalar(dst, input, value)
+}
+
+// RevDivScalarFloat64s divides value by each element of the input and writes back the result into dst slice
+func RevDivScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.rdivScalar(dst, input, value)
+}
+
+// MinOfFloat64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MinOfFloat64s(dst, input1, input2 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.minOf(dst, input1, input2)
+}
+
+// MaxOfFloat64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MaxOfFloat64s(dst, input1, input2 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.maxOf(dst, input1, input2)
+}
+
+// MinOfScalarFloat64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above. If either the element or value is NaN, the result is NaN.
+func MinOfScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarFloat64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below. If either the element or value is NaN, the result is NaN.
+func MaxOfScalarFloat64s(dst, input []float64, value float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.maxOfScalar(dst, input, value)
+}
+
+// ClampFloat64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi. If either the element or a bound is NaN, the result is NaN.
+func ClampFloat64s(dst, input []float64, lo, hi float64) []float64 {
+	dst = dst[:shortest(dst, input)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.clamp(dst, input, lo, hi)
+}
+
+// EqualsFloat64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat64.equals(input, value, bitmap)
+}
+
+// LessThanFloat64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat64.lessThan(input, value, bitmap)
+}
+
+// GreaterThanFloat64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat64.greaterThan(input, value, bitmap)
+}
+
+// BetweenFloat64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenFloat64s(input []float64, lo, hi float64, bitmap []uint64) []uint64 {
+	input, bitmap = bitmapOf(input, bitmap)
+	if len(input) == 0 {
+		return bitmap
+	}
+	return tableFloat64.between(input, lo, hi, bitmap)
+}
+
+// CompareFloat64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareFloat64s(input1, input2 []float64, op Comparison, bitmap []uint64) []uint64 {
+	input1, bitmap = bitmapOf(input1[:shortest(input1, input2)], bitmap)
+	if len(input1) == 0 {
+		return bitmap
+	}
+	return tableFloat64.compare(input1, input2, op, bitmap)
+}
+
+// SumFloat64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumFloat64sMasked(input []float64, mask []uint64) float64 {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0
+	}
+	return tableFloat64.sumMasked(input, mask)
+}
+
+// MinFloat64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MinFloat64sMasked(input []float64, mask []uint64) (float64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableFloat64.minMasked(input, mask)
+}
+
+// MaxFloat64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MaxFloat64sMasked(input []float64, mask []uint64) (float64, bool) {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 {
+		return 0, false
+	}
+	return tableFloat64.maxMasked(input, mask)
+}
+
+// CompressFloat64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressFloat64s(dst, input []float64, mask []uint64) int {
+	input, mask = bitmapOf(input, mask)
+	if len(input) == 0 || len(dst) == 0 {
+		return 0
+	}
+	return tableFloat64.compress(dst, input, mask)
+}
+
+// SelectFloat64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectFloat64s(dst, input1, input2 []float64, mask []uint64) []float64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input1, input2)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.blend(dst, input1, input2, mask)
+}
+
+// SelectScalarFloat64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarFloat64s(dst, input []float64, mask []uint64, value float64) []float64 {
+	dst, mask = bitmapOf(dst[:shortest(dst, input)], mask)
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.blendScalar(dst, input, mask, value)
+}
+
+// FMAFloat64s multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func FMAFloat64s(dst, input1, input2, input3 []float64) []float64 {
+	dst = dst[:shortest(dst, input1, input2, input3)]
+	if len(dst) == 0 {
 		return dst
 	}
-	return div(dst, input1, input2)
+	return tableFloat64.fma(dst, input1, input2, input3)
 }
 
+// AXPYFloat64s multiplies x by alpha, adds y and writes back the result into dst slice
+func AXPYFloat64s(dst []float64, alpha float64, x, y []float64) []float64 {
+	dst = dst[:shortest(dst, x, y)]
+	if len(dst) == 0 {
+		return dst
+	}
+	return tableFloat64.axpy(dst, alpha, x, y)
+}
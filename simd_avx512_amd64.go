@@ -0,0 +1,3076 @@
+// Copyright (c) Roman Atachiants and contributors. All rights reserved.
+// Licensed under the MIT license. See LICENSE file in the project root for details.
+
+//go:build amd64
+// +build amd64
+
+package simd
+
+import (
+	"unsafe"
+)
+
+
+//go:noescape
+func _uint8_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_compress_vbmi2(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint8_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _uint16_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_compress_vbmi2(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint16_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _uint32_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint32_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _uint64_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _uint64_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _int8_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_compress_vbmi2(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int8_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _int16_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_compress_vbmi2(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int16_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _int32_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int32_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _int64_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_div(input1, input2, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_div_scalar(input, magic, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_rdiv_scalar(input, value, output, index unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _int64_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _float32_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sum_wide(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_nanmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_nanmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sum_kahan(input, sum, err unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sum_pairwise(input, stack unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_div(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_div_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_rdiv_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_fma(input1, input2, input3, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_axpy(alpha, x, y, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_fma3(input1, input2, input3, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float32_avx512_axpy_fma3(alpha, x, y, output unsafe.Pointer, info uint64)
+
+//go:noescape
+func _float64_avx512_sum(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_min(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_max(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_nanmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_nanmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_sum_kahan(input, sum, err unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_sum_pairwise(input, stack unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_minmax(input, min, max unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_argmin(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_argmax(input, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_dot(input1, input2, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_add(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_sub(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_mul(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_div(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_add_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_sub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_rsub_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_mul_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_div_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_rdiv_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_min_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_max_of(input1, input2, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_min_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_max_of_scalar(input, value, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_clamp(input, bounds, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_equals(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_less(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_greater(input, value, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_between(input, bounds, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_compare_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_compare_less(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_compare_less_equal(input1, input2, bitmap unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_sum_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_min_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_max_masked(input, mask, result unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_compress(input, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_blend(input1, input2, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_blend_scalar(input, value, mask, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_fma(input1, input2, input3, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_axpy(alpha, x, y, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_fma3(input1, input2, input3, output unsafe.Pointer, info uint64)
+//go:noescape
+func _float64_avx512_axpy_fma3(alpha, x, y, output unsafe.Pointer, info uint64)
+
+// avx512Uint8s returns the table of avx512 functions for uint8, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Uint8s() (t table[uint8, uint64]) {
+	t.sum = func(input []uint8) (out uint8) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_uint8_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []uint8) (out uint64) {
+		if len(input) < short {
+			return sumWide[uint64](input)
+		}
+		_uint8_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []uint8) (out uint8) {
+		_uint8_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []uint8) (out uint8) {
+		_uint8_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []uint8) (lo, hi uint8) {
+		_uint8_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []uint8) (out int) {
+		_uint8_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []uint8) (out int) {
+		_uint8_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []uint8) (out uint8) {
+		_uint8_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []uint8) []uint8 {
+		_uint8_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []uint8) []uint8 {
+		_uint8_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []uint8) []uint8 {
+		_uint8_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []uint8) []uint8 {
+		var index uint64
+		_uint8_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []uint8, value uint8) []uint8 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_uint8_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_uint8_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []uint8, value uint8) []uint8 {
+		var index uint64
+		_uint8_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []uint8) []uint8 {
+		_uint8_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []uint8) []uint8 {
+		_uint8_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []uint8, value uint8) []uint8 {
+		_uint8_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []uint8, lo, hi uint8) []uint8 {
+		bounds := [2]uint8{lo, hi}
+		_uint8_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []uint8, value uint8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint8_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []uint8, value uint8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint8_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []uint8, value uint8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint8_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []uint8, lo, hi uint8, bitmap []uint64) []uint64 {
+		bounds := [2]uint8{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint8_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []uint8, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_uint8_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_uint8_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_uint8_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_uint8_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_uint8_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []uint8, mask []uint64) (out uint8) {
+		_uint8_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []uint8, mask []uint64) (out uint8, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint8_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []uint8, mask []uint64) (out uint8, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint8_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = compress[uint8]
+	if vbmi2 {
+		t.compress = func(dst, input []uint8, mask []uint64) int {
+			rows, count := compressible(dst, input, mask)
+			if rows > 0 {
+				_uint8_avx512_compress_vbmi2(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+			}
+			return count + compress(dst[count:], input[rows:], mask[rows/64:])
+		}
+	}
+	t.blend = func(dst, input1, input2 []uint8, mask []uint64) []uint8 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint8_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []uint8, mask []uint64, value uint8) []uint8 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint8_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+
+	// These run faster on 256-bit vectors, so keep the AVX2 kernels for them
+	avx2 := avx2Uint8s()
+	t.sum = avx2.sum
+	t.dot = avx2.dot
+	t.mul = avx2.mul
+	t.mulScalar = avx2.mulScalar
+	return
+}
+
+// avx512Uint16s returns the table of avx512 functions for uint16, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Uint16s() (t table[uint16, uint64]) {
+	t.sum = func(input []uint16) (out uint16) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_uint16_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []uint16) (out uint64) {
+		if len(input) < short {
+			return sumWide[uint64](input)
+		}
+		_uint16_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []uint16) (out uint16) {
+		_uint16_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []uint16) (out uint16) {
+		_uint16_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []uint16) (lo, hi uint16) {
+		_uint16_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []uint16) (out int) {
+		_uint16_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []uint16) (out int) {
+		_uint16_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []uint16) (out uint16) {
+		_uint16_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []uint16) []uint16 {
+		_uint16_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []uint16) []uint16 {
+		_uint16_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []uint16) []uint16 {
+		_uint16_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []uint16) []uint16 {
+		var index uint64
+		_uint16_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []uint16, value uint16) []uint16 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_uint16_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_uint16_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []uint16, value uint16) []uint16 {
+		var index uint64
+		_uint16_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []uint16) []uint16 {
+		_uint16_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []uint16) []uint16 {
+		_uint16_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []uint16, value uint16) []uint16 {
+		_uint16_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []uint16, lo, hi uint16) []uint16 {
+		bounds := [2]uint16{lo, hi}
+		_uint16_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []uint16, value uint16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint16_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []uint16, value uint16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint16_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []uint16, value uint16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint16_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []uint16, lo, hi uint16, bitmap []uint64) []uint64 {
+		bounds := [2]uint16{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint16_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []uint16, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_uint16_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_uint16_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_uint16_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_uint16_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_uint16_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []uint16, mask []uint64) (out uint16) {
+		_uint16_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []uint16, mask []uint64) (out uint16, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint16_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []uint16, mask []uint64) (out uint16, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint16_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = compress[uint16]
+	if vbmi2 {
+		t.compress = func(dst, input []uint16, mask []uint64) int {
+			rows, count := compressible(dst, input, mask)
+			if rows > 0 {
+				_uint16_avx512_compress_vbmi2(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+			}
+			return count + compress(dst[count:], input[rows:], mask[rows/64:])
+		}
+	}
+	t.blend = func(dst, input1, input2 []uint16, mask []uint64) []uint16 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint16_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []uint16, mask []uint64, value uint16) []uint16 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint16_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	return
+}
+
+// avx512Uint32s returns the table of avx512 functions for uint32, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Uint32s() (t table[uint32, uint64]) {
+	t.sum = func(input []uint32) (out uint32) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_uint32_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []uint32) (out uint64) {
+		if len(input) < short {
+			return sumWide[uint64](input)
+		}
+		_uint32_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []uint32) (out uint32) {
+		_uint32_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []uint32) (out uint32) {
+		_uint32_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []uint32) (lo, hi uint32) {
+		_uint32_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []uint32) (out int) {
+		_uint32_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []uint32) (out int) {
+		_uint32_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []uint32) (out uint32) {
+		_uint32_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []uint32) []uint32 {
+		_uint32_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []uint32) []uint32 {
+		_uint32_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []uint32) []uint32 {
+		_uint32_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []uint32) []uint32 {
+		var index uint64
+		_uint32_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []uint32, value uint32) []uint32 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_uint32_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_uint32_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []uint32, value uint32) []uint32 {
+		var index uint64
+		_uint32_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []uint32) []uint32 {
+		_uint32_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []uint32) []uint32 {
+		_uint32_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []uint32, value uint32) []uint32 {
+		_uint32_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []uint32, lo, hi uint32) []uint32 {
+		bounds := [2]uint32{lo, hi}
+		_uint32_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []uint32, value uint32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint32_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []uint32, value uint32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint32_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []uint32, value uint32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint32_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []uint32, lo, hi uint32, bitmap []uint64) []uint64 {
+		bounds := [2]uint32{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint32_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []uint32, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_uint32_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_uint32_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_uint32_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_uint32_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_uint32_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []uint32, mask []uint64) (out uint32) {
+		_uint32_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []uint32, mask []uint64) (out uint32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint32_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []uint32, mask []uint64) (out uint32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint32_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []uint32, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_uint32_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []uint32, mask []uint64) []uint32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint32_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []uint32, mask []uint64, value uint32) []uint32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint32_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+
+	// These run faster on 256-bit vectors, so keep the AVX2 kernels for them
+	avx2 := avx2Uint32s()
+	t.sum = avx2.sum
+	return
+}
+
+// avx512Uint64s returns the table of avx512 functions for uint64, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Uint64s() (t table[uint64, uint64]) {
+	t.sum = func(input []uint64) (out uint64) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_uint64_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []uint64) (out uint64) {
+		_uint64_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []uint64) (out uint64) {
+		_uint64_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []uint64) (lo, hi uint64) {
+		_uint64_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []uint64) (out int) {
+		_uint64_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []uint64) (out int) {
+		_uint64_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []uint64) (out uint64) {
+		_uint64_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []uint64) []uint64 {
+		_uint64_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []uint64) []uint64 {
+		_uint64_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []uint64) []uint64 {
+		_uint64_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []uint64) []uint64 {
+		var index uint64
+		_uint64_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []uint64, value uint64) []uint64 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_uint64_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_uint64_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []uint64, value uint64) []uint64 {
+		var index uint64
+		_uint64_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []uint64) []uint64 {
+		_uint64_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []uint64) []uint64 {
+		_uint64_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []uint64, value uint64) []uint64 {
+		_uint64_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []uint64, lo, hi uint64) []uint64 {
+		bounds := [2]uint64{lo, hi}
+		_uint64_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []uint64, value uint64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint64_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []uint64, value uint64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint64_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []uint64, value uint64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint64_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []uint64, lo, hi uint64, bitmap []uint64) []uint64 {
+		bounds := [2]uint64{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_uint64_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []uint64, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_uint64_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_uint64_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_uint64_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_uint64_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_uint64_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []uint64, mask []uint64) (out uint64) {
+		_uint64_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []uint64, mask []uint64) (out uint64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint64_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []uint64, mask []uint64) (out uint64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_uint64_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []uint64, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_uint64_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []uint64, mask []uint64) []uint64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint64_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []uint64, mask []uint64, value uint64) []uint64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_uint64_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	return
+}
+
+// avx512Int8s returns the table of avx512 functions for int8, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Int8s() (t table[int8, int64]) {
+	t.sum = func(input []int8) (out int8) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_int8_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []int8) (out int64) {
+		if len(input) < short {
+			return sumWide[int64](input)
+		}
+		_int8_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []int8) (out int8) {
+		_int8_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []int8) (out int8) {
+		_int8_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []int8) (lo, hi int8) {
+		_int8_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []int8) (out int) {
+		_int8_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []int8) (out int) {
+		_int8_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []int8) (out int8) {
+		_int8_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []int8) []int8 {
+		_int8_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []int8) []int8 {
+		_int8_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []int8) []int8 {
+		_int8_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []int8) []int8 {
+		var index uint64
+		_int8_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []int8, value int8) []int8 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_int8_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_int8_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []int8, value int8) []int8 {
+		var index uint64
+		_int8_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []int8) []int8 {
+		_int8_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []int8) []int8 {
+		_int8_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []int8, value int8) []int8 {
+		_int8_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []int8, lo, hi int8) []int8 {
+		bounds := [2]int8{lo, hi}
+		_int8_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []int8, value int8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int8_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []int8, value int8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int8_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []int8, value int8, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int8_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []int8, lo, hi int8, bitmap []uint64) []uint64 {
+		bounds := [2]int8{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int8_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []int8, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_int8_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_int8_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_int8_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_int8_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_int8_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []int8, mask []uint64) (out int8) {
+		_int8_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []int8, mask []uint64) (out int8, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int8_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []int8, mask []uint64) (out int8, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int8_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = compress[int8]
+	if vbmi2 {
+		t.compress = func(dst, input []int8, mask []uint64) int {
+			rows, count := compressible(dst, input, mask)
+			if rows > 0 {
+				_int8_avx512_compress_vbmi2(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+			}
+			return count + compress(dst[count:], input[rows:], mask[rows/64:])
+		}
+	}
+	t.blend = func(dst, input1, input2 []int8, mask []uint64) []int8 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int8_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []int8, mask []uint64, value int8) []int8 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int8_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+
+	// These run faster on 256-bit vectors, so keep the AVX2 kernels for them
+	avx2 := avx2Int8s()
+	t.dot = avx2.dot
+	t.mul = avx2.mul
+	t.mulScalar = avx2.mulScalar
+	t.equals = avx2.equals
+	return
+}
+
+// avx512Int16s returns the table of avx512 functions for int16, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Int16s() (t table[int16, int64]) {
+	t.sum = func(input []int16) (out int16) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_int16_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []int16) (out int64) {
+		if len(input) < short {
+			return sumWide[int64](input)
+		}
+		_int16_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []int16) (out int16) {
+		_int16_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []int16) (out int16) {
+		_int16_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []int16) (lo, hi int16) {
+		_int16_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []int16) (out int) {
+		_int16_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []int16) (out int) {
+		_int16_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []int16) (out int16) {
+		_int16_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []int16) []int16 {
+		_int16_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []int16) []int16 {
+		_int16_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []int16) []int16 {
+		_int16_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []int16) []int16 {
+		var index uint64
+		_int16_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []int16, value int16) []int16 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_int16_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_int16_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []int16, value int16) []int16 {
+		var index uint64
+		_int16_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []int16) []int16 {
+		_int16_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []int16) []int16 {
+		_int16_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []int16, value int16) []int16 {
+		_int16_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []int16, lo, hi int16) []int16 {
+		bounds := [2]int16{lo, hi}
+		_int16_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []int16, value int16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int16_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []int16, value int16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int16_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []int16, value int16, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int16_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []int16, lo, hi int16, bitmap []uint64) []uint64 {
+		bounds := [2]int16{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int16_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []int16, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_int16_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_int16_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_int16_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_int16_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_int16_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []int16, mask []uint64) (out int16) {
+		_int16_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []int16, mask []uint64) (out int16, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int16_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []int16, mask []uint64) (out int16, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int16_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = compress[int16]
+	if vbmi2 {
+		t.compress = func(dst, input []int16, mask []uint64) int {
+			rows, count := compressible(dst, input, mask)
+			if rows > 0 {
+				_int16_avx512_compress_vbmi2(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+			}
+			return count + compress(dst[count:], input[rows:], mask[rows/64:])
+		}
+	}
+	t.blend = func(dst, input1, input2 []int16, mask []uint64) []int16 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int16_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []int16, mask []uint64, value int16) []int16 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int16_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	return
+}
+
+// avx512Int32s returns the table of avx512 functions for int32, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Int32s() (t table[int32, int64]) {
+	t.sum = func(input []int32) (out int32) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_int32_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []int32) (out int64) {
+		if len(input) < short {
+			return sumWide[int64](input)
+		}
+		_int32_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []int32) (out int32) {
+		_int32_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []int32) (out int32) {
+		_int32_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []int32) (lo, hi int32) {
+		_int32_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []int32) (out int) {
+		_int32_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []int32) (out int) {
+		_int32_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []int32) (out int32) {
+		_int32_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []int32) []int32 {
+		_int32_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []int32) []int32 {
+		_int32_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []int32) []int32 {
+		_int32_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []int32) []int32 {
+		var index uint64
+		_int32_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []int32, value int32) []int32 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_int32_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_int32_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []int32, value int32) []int32 {
+		var index uint64
+		_int32_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []int32) []int32 {
+		_int32_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []int32) []int32 {
+		_int32_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []int32, value int32) []int32 {
+		_int32_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []int32, lo, hi int32) []int32 {
+		bounds := [2]int32{lo, hi}
+		_int32_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []int32, value int32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int32_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []int32, value int32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int32_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []int32, value int32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int32_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []int32, lo, hi int32, bitmap []uint64) []uint64 {
+		bounds := [2]int32{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int32_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []int32, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_int32_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_int32_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_int32_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_int32_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_int32_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []int32, mask []uint64) (out int32) {
+		_int32_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []int32, mask []uint64) (out int32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int32_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []int32, mask []uint64) (out int32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int32_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []int32, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_int32_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []int32, mask []uint64) []int32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int32_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []int32, mask []uint64, value int32) []int32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int32_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	return
+}
+
+// avx512Int64s returns the table of avx512 functions for int64, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Int64s() (t table[int64, int64]) {
+	t.sum = func(input []int64) (out int64) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_int64_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []int64) (out int64) {
+		_int64_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []int64) (out int64) {
+		_int64_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minmax = func(input []int64) (lo, hi int64) {
+		_int64_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []int64) (out int) {
+		_int64_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []int64) (out int) {
+		_int64_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []int64) (out int64) {
+		_int64_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []int64) []int64 {
+		_int64_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []int64) []int64 {
+		_int64_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []int64) []int64 {
+		_int64_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []int64) []int64 {
+		var index uint64
+		_int64_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.addScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []int64, value int64) []int64 {
+		magic, ok := reciprocal(value)
+		if !ok { // dividing by 1 or -1 is the same as multiplying by it
+			_int64_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		_int64_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&magic), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []int64, value int64) []int64 {
+		var index uint64
+		_int64_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), unsafe.Pointer(&index), uint64(len(dst)))
+		if index < uint64(len(dst)) {
+			panic(&DivideByZeroError{Index: int(index)})
+		}
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []int64) []int64 {
+		_int64_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []int64) []int64 {
+		_int64_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []int64, value int64) []int64 {
+		_int64_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []int64, lo, hi int64) []int64 {
+		bounds := [2]int64{lo, hi}
+		_int64_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []int64, value int64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int64_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []int64, value int64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int64_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []int64, value int64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int64_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []int64, lo, hi int64, bitmap []uint64) []uint64 {
+		bounds := [2]int64{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_int64_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []int64, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_int64_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_int64_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_int64_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_int64_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_int64_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []int64, mask []uint64) (out int64) {
+		_int64_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []int64, mask []uint64) (out int64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int64_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []int64, mask []uint64) (out int64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_int64_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []int64, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_int64_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []int64, mask []uint64) []int64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int64_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []int64, mask []uint64, value int64) []int64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_int64_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	return
+}
+
+// avx512Float32s returns the table of avx512 functions for float32, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Float32s() (t table[float32, float64]) {
+	t.sum = func(input []float32) (out float32) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_float32_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumWide = func(input []float32) (out float64) {
+		if len(input) < short {
+			return sumWide[float64](input)
+		}
+		_float32_avx512_sum_wide(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []float32) (out float32) {
+		_float32_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []float32) (out float32) {
+		_float32_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.nanmin = func(input []float32) (out float32) {
+		_float32_avx512_nanmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.nanmax = func(input []float32) (out float32) {
+		_float32_avx512_nanmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumKahan = func(input []float32) float32 {
+		var sum, err [lanesFloat32]float32
+		rows := len(input) - len(input)%lanesFloat32
+		if rows > 0 {
+			_float32_avx512_sum_kahan(unsafe.Pointer(&input[0]), unsafe.Pointer(&sum), unsafe.Pointer(&err), uint64(rows))
+		}
+		return kahanTotal(sum[:], err[:], input[rows:])
+	}
+	t.sumPairwise = func(input []float32) float32 {
+		var stack [stackDepth * lanesFloat32]float32
+		rows := len(input) - len(input)%lanesFloat32
+		if rows > 0 {
+			_float32_avx512_sum_pairwise(unsafe.Pointer(&input[0]), unsafe.Pointer(&stack), uint64(rows))
+		}
+		return pairwiseTotal(stack[:lanesFloat32], input[rows:])
+	}
+	t.minmax = func(input []float32) (lo, hi float32) {
+		_float32_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []float32) (out int) {
+		_float32_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []float32) (out int) {
+		_float32_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []float32) (out float32) {
+		_float32_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.addScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []float32) []float32 {
+		_float32_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []float32, value float32) []float32 {
+		_float32_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []float32, lo, hi float32) []float32 {
+		bounds := [2]float32{lo, hi}
+		_float32_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []float32, value float32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float32_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []float32, value float32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float32_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []float32, value float32, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float32_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []float32, lo, hi float32, bitmap []uint64) []uint64 {
+		bounds := [2]float32{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float32_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []float32, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_float32_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_float32_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_float32_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_float32_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_float32_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []float32, mask []uint64) (out float32) {
+		_float32_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []float32, mask []uint64) (out float32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_float32_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []float32, mask []uint64) (out float32, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_float32_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []float32, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_float32_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []float32, mask []uint64) []float32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_float32_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []float32, mask []uint64, value float32) []float32 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_float32_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	t.fma = func(dst, input1, input2, input3 []float32) []float32 {
+		_float32_avx512_fma(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&input3[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.axpy = func(dst []float32, alpha float32, x, y []float32) []float32 {
+		_float32_avx512_axpy(unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), unsafe.Pointer(&y[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	if fma {
+		t.fma = func(dst, input1, input2, input3 []float32) []float32 {
+			_float32_avx512_fma3(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&input3[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		t.axpy = func(dst []float32, alpha float32, x, y []float32) []float32 {
+			_float32_avx512_axpy_fma3(unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), unsafe.Pointer(&y[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+	}
+
+	// These run faster on 256-bit vectors, so keep the AVX2 kernels for them
+	avx2 := avx2Float32s()
+	t.sum = avx2.sum
+	t.sumKahan = avx2.sumKahan
+	return
+}
+
+// avx512Float64s returns the table of avx512 functions for float64, these expect a non-empty
+// input, apart from the sums, and the dst slice to be already truncated to the length of the inputs.
+func avx512Float64s() (t table[float64, float64]) {
+	t.sum = func(input []float64) (out float64) {
+		if len(input) < short {
+			return sum(input)
+		}
+		_float64_avx512_sum(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.min = func(input []float64) (out float64) {
+		_float64_avx512_min(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.max = func(input []float64) (out float64) {
+		_float64_avx512_max(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.nanmin = func(input []float64) (out float64) {
+		_float64_avx512_nanmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.nanmax = func(input []float64) (out float64) {
+		_float64_avx512_nanmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.sumKahan = func(input []float64) float64 {
+		var sum, err [lanesFloat64]float64
+		rows := len(input) - len(input)%lanesFloat64
+		if rows > 0 {
+			_float64_avx512_sum_kahan(unsafe.Pointer(&input[0]), unsafe.Pointer(&sum), unsafe.Pointer(&err), uint64(rows))
+		}
+		return kahanTotal(sum[:], err[:], input[rows:])
+	}
+	t.sumPairwise = func(input []float64) float64 {
+		var stack [stackDepth * lanesFloat64]float64
+		rows := len(input) - len(input)%lanesFloat64
+		if rows > 0 {
+			_float64_avx512_sum_pairwise(unsafe.Pointer(&input[0]), unsafe.Pointer(&stack), uint64(rows))
+		}
+		return pairwiseTotal(stack[:lanesFloat64], input[rows:])
+	}
+	t.minmax = func(input []float64) (lo, hi float64) {
+		_float64_avx512_minmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&lo), unsafe.Pointer(&hi), uint64(len(input)))
+		return
+	}
+	t.argmin = func(input []float64) (out int) {
+		_float64_avx512_argmin(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.argmax = func(input []float64) (out int) {
+		_float64_avx512_argmax(unsafe.Pointer(&input[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.dot = func(input1, input2 []float64) (out float64) {
+		_float64_avx512_dot(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&out), uint64(len(input1)))
+		return
+	}
+	t.add = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_add(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.sub = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_sub(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mul = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_mul(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.div = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_div(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.addScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_add_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.subScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_sub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rsubScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_rsub_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.mulScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_mul_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.divScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_div_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.rdivScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_rdiv_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOf = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_min_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOf = func(dst, input1, input2 []float64) []float64 {
+		_float64_avx512_max_of(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.minOfScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_min_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.maxOfScalar = func(dst, input []float64, value float64) []float64 {
+		_float64_avx512_max_of_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.clamp = func(dst, input []float64, lo, hi float64) []float64 {
+		bounds := [2]float64{lo, hi}
+		_float64_avx512_clamp(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.equals = func(input []float64, value float64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float64_avx512_equals(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		equals(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.lessThan = func(input []float64, value float64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float64_avx512_less(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		lessThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.greaterThan = func(input []float64, value float64, bitmap []uint64) []uint64 {
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float64_avx512_greater(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		greaterThan(input[rows:], value, bitmap[rows/64:])
+		return bitmap
+	}
+	t.between = func(input []float64, lo, hi float64, bitmap []uint64) []uint64 {
+		bounds := [2]float64{lo, hi}
+		rows := len(input) - len(input)%64
+		if rows > 0 {
+			_float64_avx512_between(unsafe.Pointer(&input[0]), unsafe.Pointer(&bounds), unsafe.Pointer(&bitmap[0]), uint64(rows))
+		}
+		between(input[rows:], lo, hi, bitmap[rows/64:])
+		return bitmap
+	}
+	t.compare = func(input1, input2 []float64, op Comparison, bitmap []uint64) []uint64 {
+		rows := len(input1) - len(input1)%64
+		if rows > 0 {
+			in1, in2, out := unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&bitmap[0])
+			switch op {
+			case Equal, NotEqual:
+				_float64_avx512_compare_equal(in1, in2, out, uint64(rows))
+			case Less:
+				_float64_avx512_compare_less(in1, in2, out, uint64(rows))
+			case LessEqual:
+				_float64_avx512_compare_less_equal(in1, in2, out, uint64(rows))
+			case Greater:
+				_float64_avx512_compare_less(in2, in1, out, uint64(rows))
+			case GreaterEqual:
+				_float64_avx512_compare_less_equal(in2, in1, out, uint64(rows))
+			default:
+				panic("simd: unknown comparison " + op.String())
+			}
+			if op == NotEqual {
+				for i := range bitmap[:rows/64] {
+					bitmap[i] = ^bitmap[i]
+				}
+			}
+		}
+		compare(input1[rows:], input2[rows:], op, bitmap[rows/64:])
+		return bitmap
+	}
+	t.sumMasked = func(input []float64, mask []uint64) (out float64) {
+		_float64_avx512_sum_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return
+	}
+	t.minMasked = func(input []float64, mask []uint64) (out float64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_float64_avx512_min_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.maxMasked = func(input []float64, mask []uint64) (out float64, ok bool) {
+		first, ok := firstOf(input, mask)
+		if !ok {
+			return 0, false
+		}
+		out = input[first]
+		_float64_avx512_max_masked(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&out), uint64(len(input)))
+		return out, true
+	}
+	t.compress = func(dst, input []float64, mask []uint64) int {
+		rows, count := compressible(dst, input, mask)
+		if rows > 0 {
+			_float64_avx512_compress(unsafe.Pointer(&input[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		return count + compress(dst[count:], input[rows:], mask[rows/64:])
+	}
+	t.blend = func(dst, input1, input2 []float64, mask []uint64) []float64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_float64_avx512_blend(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blend(dst[rows:], input1[rows:], input2[rows:], mask[rows/64:])
+		return dst
+	}
+	t.blendScalar = func(dst, input []float64, mask []uint64, value float64) []float64 {
+		rows := len(dst) - len(dst)%64
+		if rows > 0 {
+			_float64_avx512_blend_scalar(unsafe.Pointer(&input[0]), unsafe.Pointer(&value), unsafe.Pointer(&mask[0]), unsafe.Pointer(&dst[0]), uint64(rows))
+		}
+		blendScalar(dst[rows:], input[rows:], mask[rows/64:], value)
+		return dst
+	}
+	t.fma = func(dst, input1, input2, input3 []float64) []float64 {
+		_float64_avx512_fma(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&input3[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	t.axpy = func(dst []float64, alpha float64, x, y []float64) []float64 {
+		_float64_avx512_axpy(unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), unsafe.Pointer(&y[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+		return dst
+	}
+	if fma {
+		t.fma = func(dst, input1, input2, input3 []float64) []float64 {
+			_float64_avx512_fma3(unsafe.Pointer(&input1[0]), unsafe.Pointer(&input2[0]), unsafe.Pointer(&input3[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+		t.axpy = func(dst []float64, alpha float64, x, y []float64) []float64 {
+			_float64_avx512_axpy_fma3(unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), unsafe.Pointer(&y[0]), unsafe.Pointer(&dst[0]), uint64(len(dst)))
+			return dst
+		}
+	}
+
+	// These run faster on 256-bit vectors, so keep the AVX2 kernels for them
+	avx2 := avx2Float64s()
+	t.sum = avx2.sum
+	t.sumKahan = avx2.sumKahan
+	return
+}
+
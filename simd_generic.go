@@ -6,6 +6,9 @@
 
 package simd
 
+// dispatch has nothing to select, since there are no vectorized functions on this platform
+func dispatch() {}
+
 
 // ---------------------------------- Uint8 ----------------------------------
 
@@ -14,16 +17,41 @@ func SumUint8s(input []uint8) (out uint8) {
 	return sum(input)
 }
 
-// MinUint8s returns the smallest element value in the slice
+// SumUint8sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint8sToUint64(input []uint8) (out uint64) {
+	return sumWide[uint64](input)
+}
+
+// MinUint8s returns the smallest element value in the slice. It panics if the slice is empty.
 func MinUint8s(input []uint8) (out uint8) {
 	return min(input)
 }
 
-// MaxUint8s returns the largest element value in the slice
+// MaxUint8s returns the largest element value in the slice. It panics if the slice is empty.
 func MaxUint8s(input []uint8) (out uint8) {
 	return max(input)
 }
 
+// MinMaxUint8s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint8s(input []uint8) (lo, hi uint8) {
+	return minmax(input)
+}
+
+// ArgMinUint8s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint8s(input []uint8) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxUint8s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint8s(input []uint8) (out int) {
+	return argmax(input)
+}
+
+// DotUint8s computes the dot product of input1 and input2 and returns the value
+func DotUint8s(input1, input2 []uint8) (out uint8) {
+	return dot(input1, input2)
+}
+
 // AddUint8s adds input1 to input2 and writes back the result into dst slice
 func AddUint8s(dst, input1, input2 []uint8) []uint8 {
 	return add(dst, input1, input2)
@@ -39,11 +67,143 @@ func MulUint8s(dst, input1, input2 []uint8) []uint8 {
 	return mul(dst, input1, input2)
 }
 
-// DivUint8s divides input1 by input2 and writes back the result into dst slice
+// DivUint8s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
 func DivUint8s(dst, input1, input2 []uint8) []uint8 {
 	return div(dst, input1, input2)
 }
 
+// AddScalarUint8s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarUint8s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarUint8s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarUint8s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarUint8s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarUint8s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfUint8s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint8s(dst, input1, input2 []uint8) []uint8 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfUint8s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint8s(dst, input1, input2 []uint8) []uint8 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarUint8s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarUint8s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint8s(dst, input []uint8, value uint8) []uint8 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampUint8s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint8s(dst, input []uint8, lo, hi uint8) []uint8 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsUint8s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanUint8s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanUint8s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint8s(input []uint8, value uint8, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenUint8s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint8s(input []uint8, lo, hi uint8, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareUint8s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint8s(input1, input2 []uint8, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumUint8sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint8sMasked(input []uint8, mask []uint64) uint8 {
+	return sumMasked(input, mask)
+}
+
+// MinUint8sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint8sMasked(input []uint8, mask []uint64) (uint8, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxUint8sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint8sMasked(input []uint8, mask []uint64) (uint8, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressUint8s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint8s(dst, input []uint8, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectUint8s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint8s(dst, input1, input2 []uint8, mask []uint64) []uint8 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarUint8s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint8s(dst, input []uint8, mask []uint64, value uint8) []uint8 {
+	return blendScalar(dst, input, mask, value)
+}
+
 // ---------------------------------- Uint16 ----------------------------------
 
 // SumUint16s sums up all of the elements of the slice and returns the value
@@ -51,16 +211,41 @@ func SumUint16s(input []uint16) (out uint16) {
 	return sum(input)
 }
 
-// MinUint16s returns the smallest element value in the slice
+// SumUint16sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint16sToUint64(input []uint16) (out uint64) {
+	return sumWide[uint64](input)
+}
+
+// MinUint16s returns the smallest element value in the slice. It panics if the slice is empty.
 func MinUint16s(input []uint16) (out uint16) {
 	return min(input)
 }
 
-// MaxUint16s returns the largest element value in the slice
+// MaxUint16s returns the largest element value in the slice. It panics if the slice is empty.
 func MaxUint16s(input []uint16) (out uint16) {
 	return max(input)
 }
 
+// MinMaxUint16s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint16s(input []uint16) (lo, hi uint16) {
+	return minmax(input)
+}
+
+// ArgMinUint16s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint16s(input []uint16) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxUint16s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint16s(input []uint16) (out int) {
+	return argmax(input)
+}
+
+// DotUint16s computes the dot product of input1 and input2 and returns the value
+func DotUint16s(input1, input2 []uint16) (out uint16) {
+	return dot(input1, input2)
+}
+
 // AddUint16s adds input1 to input2 and writes back the result into dst slice
 func AddUint16s(dst, input1, input2 []uint16) []uint16 {
 	return add(dst, input1, input2)
@@ -76,11 +261,143 @@ func MulUint16s(dst, input1, input2 []uint16) []uint16 {
 	return mul(dst, input1, input2)
 }
 
-// DivUint16s divides input1 by input2 and writes back the result into dst slice
+// DivUint16s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
 func DivUint16s(dst, input1, input2 []uint16) []uint16 {
 	return div(dst, input1, input2)
 }
 
+// AddScalarUint16s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarUint16s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarUint16s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarUint16s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarUint16s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarUint16s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfUint16s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint16s(dst, input1, input2 []uint16) []uint16 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfUint16s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint16s(dst, input1, input2 []uint16) []uint16 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarUint16s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarUint16s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint16s(dst, input []uint16, value uint16) []uint16 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampUint16s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint16s(dst, input []uint16, lo, hi uint16) []uint16 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsUint16s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanUint16s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanUint16s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint16s(input []uint16, value uint16, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenUint16s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint16s(input []uint16, lo, hi uint16, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareUint16s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint16s(input1, input2 []uint16, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumUint16sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint16sMasked(input []uint16, mask []uint64) uint16 {
+	return sumMasked(input, mask)
+}
+
+// MinUint16sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint16sMasked(input []uint16, mask []uint64) (uint16, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxUint16sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint16sMasked(input []uint16, mask []uint64) (uint16, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressUint16s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint16s(dst, input []uint16, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectUint16s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint16s(dst, input1, input2 []uint16, mask []uint64) []uint16 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarUint16s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint16s(dst, input []uint16, mask []uint64, value uint16) []uint16 {
+	return blendScalar(dst, input, mask, value)
+}
+
 // ---------------------------------- Uint32 ----------------------------------
 
 // SumUint32s sums up all of the elements of the slice and returns the value
@@ -88,16 +405,41 @@ func SumUint32s(input []uint32) (out uint32) {
 	return sum(input)
 }
 
-// MinUint32s returns the smallest element value in the slice
+// SumUint32sToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUint32sToUint64(input []uint32) (out uint64) {
+	return sumWide[uint64](input)
+}
+
+// MinUint32s returns the smallest element value in the slice. It panics if the slice is empty.
 func MinUint32s(input []uint32) (out uint32) {
 	return min(input)
 }
 
-// MaxUint32s returns the largest element value in the slice
+// MaxUint32s returns the largest element value in the slice. It panics if the slice is empty.
 func MaxUint32s(input []uint32) (out uint32) {
 	return max(input)
 }
 
+// MinMaxUint32s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint32s(input []uint32) (lo, hi uint32) {
+	return minmax(input)
+}
+
+// ArgMinUint32s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint32s(input []uint32) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxUint32s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint32s(input []uint32) (out int) {
+	return argmax(input)
+}
+
+// DotUint32s computes the dot product of input1 and input2 and returns the value
+func DotUint32s(input1, input2 []uint32) (out uint32) {
+	return dot(input1, input2)
+}
+
 // AddUint32s adds input1 to input2 and writes back the result into dst slice
 func AddUint32s(dst, input1, input2 []uint32) []uint32 {
 	return add(dst, input1, input2)
@@ -113,250 +455,1401 @@ func MulUint32s(dst, input1, input2 []uint32) []uint32 {
 	return mul(dst, input1, input2)
 }
 
-// DivUint32s divides input1 by input2 and writes back the result into dst slice
+// DivUint32s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
 func DivUint32s(dst, input1, input2 []uint32) []uint32 {
 	return div(dst, input1, input2)
 }
 
-// ---------------------------------- Uint64 ----------------------------------
-
-// SumUint64s sums up all of the elements of the slice and returns the value
-func SumUint64s(input []uint64) (out uint64) {
-	return sum(input)
+// AddScalarUint32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return addScalar(dst, input, value)
 }
 
-// MinUint64s returns the smallest element value in the slice
-func MinUint64s(input []uint64) (out uint64) {
-	return min(input)
+// SubScalarUint32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return subScalar(dst, input, value)
 }
 
-// MaxUint64s returns the largest element value in the slice
-func MaxUint64s(input []uint64) (out uint64) {
-	return max(input)
+// RevSubScalarUint32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return rsubScalar(dst, input, value)
 }
 
-// AddUint64s adds input1 to input2 and writes back the result into dst slice
-func AddUint64s(dst, input1, input2 []uint64) []uint64 {
-	return add(dst, input1, input2)
+// MulScalarUint32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return mulScalar(dst, input, value)
 }
 
-// SubUint64s subtracts input2 from input1 and writes back the result into dst slice
-func SubUint64s(dst, input1, input2 []uint64) []uint64 {
-	return sub(dst, input1, input2)
+// DivScalarUint32s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return divScalar(dst, input, value)
 }
 
-// MulUint64s multiplies input1 by input2 and writes back the result into dst slice
-func MulUint64s(dst, input1, input2 []uint64) []uint64 {
-	return mul(dst, input1, input2)
+// RevDivScalarUint32s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return rdivScalar(dst, input, value)
 }
 
-// DivUint64s divides input1 by input2 and writes back the result into dst slice
-func DivUint64s(dst, input1, input2 []uint64) []uint64 {
-	return div(dst, input1, input2)
+// MinOfUint32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint32s(dst, input1, input2 []uint32) []uint32 {
+	return minOf(dst, input1, input2)
 }
 
-// ---------------------------------- Int8 ----------------------------------
-
-// SumInt8s sums up all of the elements of the slice and returns the value
-func SumInt8s(input []int8) (out int8) {
-	return sum(input)
+// MaxOfUint32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint32s(dst, input1, input2 []uint32) []uint32 {
+	return maxOf(dst, input1, input2)
 }
 
-// MinInt8s returns the smallest element value in the slice
-func MinInt8s(input []int8) (out int8) {
-	return min(input)
+// MinOfScalarUint32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return minOfScalar(dst, input, value)
 }
 
-// MaxInt8s returns the largest element value in the slice
-func MaxInt8s(input []int8) (out int8) {
-	return max(input)
+// MaxOfScalarUint32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint32s(dst, input []uint32, value uint32) []uint32 {
+	return maxOfScalar(dst, input, value)
 }
 
-// AddInt8s adds input1 to input2 and writes back the result into dst slice
-func AddInt8s(dst, input1, input2 []int8) []int8 {
-	return add(dst, input1, input2)
+// ClampUint32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint32s(dst, input []uint32, lo, hi uint32) []uint32 {
+	return clamp(dst, input, lo, hi)
 }
 
-// SubInt8s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt8s(dst, input1, input2 []int8) []int8 {
-	return sub(dst, input1, input2)
+// EqualsUint32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
 }
 
-// MulInt8s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt8s(dst, input1, input2 []int8) []int8 {
-	return mul(dst, input1, input2)
+// LessThanUint32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
 }
 
-// DivInt8s divides input1 by input2 and writes back the result into dst slice
-func DivInt8s(dst, input1, input2 []int8) []int8 {
-	return div(dst, input1, input2)
+// GreaterThanUint32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint32s(input []uint32, value uint32, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
 }
 
-// ---------------------------------- Int16 ----------------------------------
+// BetweenUint32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint32s(input []uint32, lo, hi uint32, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
 
-// SumInt16s sums up all of the elements of the slice and returns the value
-func SumInt16s(input []int16) (out int16) {
-	return sum(input)
+// CompareUint32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint32s(input1, input2 []uint32, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
 }
 
-// MinInt16s returns the smallest element value in the slice
-func MinInt16s(input []int16) (out int16) {
-	return min(input)
+// SumUint32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint32sMasked(input []uint32, mask []uint64) uint32 {
+	return sumMasked(input, mask)
 }
 
-// MaxInt16s returns the largest element value in the slice
-func MaxInt16s(input []int16) (out int16) {
-	return max(input)
+// MinUint32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint32sMasked(input []uint32, mask []uint64) (uint32, bool) {
+	return minMasked(input, mask)
 }
 
-// AddInt16s adds input1 to input2 and writes back the result into dst slice
-func AddInt16s(dst, input1, input2 []int16) []int16 {
-	return add(dst, input1, input2)
+// MaxUint32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint32sMasked(input []uint32, mask []uint64) (uint32, bool) {
+	return maxMasked(input, mask)
 }
 
-// SubInt16s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt16s(dst, input1, input2 []int16) []int16 {
-	return sub(dst, input1, input2)
+// CompressUint32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint32s(dst, input []uint32, mask []uint64) int {
+	return compress(dst, input, mask)
 }
 
-// MulInt16s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt16s(dst, input1, input2 []int16) []int16 {
-	return mul(dst, input1, input2)
+// SelectUint32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint32s(dst, input1, input2 []uint32, mask []uint64) []uint32 {
+	return blend(dst, input1, input2, mask)
 }
 
-// DivInt16s divides input1 by input2 and writes back the result into dst slice
-func DivInt16s(dst, input1, input2 []int16) []int16 {
-	return div(dst, input1, input2)
+// SelectScalarUint32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint32s(dst, input []uint32, mask []uint64, value uint32) []uint32 {
+	return blendScalar(dst, input, mask, value)
 }
 
-// ---------------------------------- Int32 ----------------------------------
+// ---------------------------------- Uint64 ----------------------------------
 
-// SumInt32s sums up all of the elements of the slice and returns the value
-func SumInt32s(input []int32) (out int32) {
+// SumUint64s sums up all of the elements of the slice and returns the value
+func SumUint64s(input []uint64) (out uint64) {
 	return sum(input)
 }
 
-// MinInt32s returns the smallest element value in the slice
-func MinInt32s(input []int32) (out int32) {
+// MinUint64s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUint64s(input []uint64) (out uint64) {
 	return min(input)
 }
 
-// MaxInt32s returns the largest element value in the slice
-func MaxInt32s(input []int32) (out int32) {
+// MaxUint64s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUint64s(input []uint64) (out uint64) {
 	return max(input)
 }
 
-// AddInt32s adds input1 to input2 and writes back the result into dst slice
-func AddInt32s(dst, input1, input2 []int32) []int32 {
+// MinMaxUint64s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUint64s(input []uint64) (lo, hi uint64) {
+	return minmax(input)
+}
+
+// ArgMinUint64s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUint64s(input []uint64) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxUint64s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUint64s(input []uint64) (out int) {
+	return argmax(input)
+}
+
+// DotUint64s computes the dot product of input1 and input2 and returns the value
+func DotUint64s(input1, input2 []uint64) (out uint64) {
+	return dot(input1, input2)
+}
+
+// AddUint64s adds input1 to input2 and writes back the result into dst slice
+func AddUint64s(dst, input1, input2 []uint64) []uint64 {
 	return add(dst, input1, input2)
 }
 
-// SubInt32s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt32s(dst, input1, input2 []int32) []int32 {
+// SubUint64s subtracts input2 from input1 and writes back the result into dst slice
+func SubUint64s(dst, input1, input2 []uint64) []uint64 {
 	return sub(dst, input1, input2)
 }
 
-// MulInt32s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt32s(dst, input1, input2 []int32) []int32 {
+// MulUint64s multiplies input1 by input2 and writes back the result into dst slice
+func MulUint64s(dst, input1, input2 []uint64) []uint64 {
 	return mul(dst, input1, input2)
 }
 
-// DivInt32s divides input1 by input2 and writes back the result into dst slice
-func DivInt32s(dst, input1, input2 []int32) []int32 {
+// DivUint64s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivUint64s(dst, input1, input2 []uint64) []uint64 {
 	return div(dst, input1, input2)
 }
 
-// ---------------------------------- Int64 ----------------------------------
+// AddScalarUint64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return addScalar(dst, input, value)
+}
 
-// SumInt64s sums up all of the elements of the slice and returns the value
-func SumInt64s(input []int64) (out int64) {
-	return sum(input)
+// SubScalarUint64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return subScalar(dst, input, value)
 }
 
-// MinInt64s returns the smallest element value in the slice
-func MinInt64s(input []int64) (out int64) {
-	return min(input)
+// RevSubScalarUint64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return rsubScalar(dst, input, value)
 }
 
-// MaxInt64s returns the largest element value in the slice
-func MaxInt64s(input []int64) (out int64) {
-	return max(input)
+// MulScalarUint64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return mulScalar(dst, input, value)
 }
 
-// AddInt64s adds input1 to input2 and writes back the result into dst slice
-func AddInt64s(dst, input1, input2 []int64) []int64 {
-	return add(dst, input1, input2)
+// DivScalarUint64s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return divScalar(dst, input, value)
 }
 
-// SubInt64s subtracts input2 from input1 and writes back the result into dst slice
-func SubInt64s(dst, input1, input2 []int64) []int64 {
-	return sub(dst, input1, input2)
+// RevDivScalarUint64s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return rdivScalar(dst, input, value)
 }
 
-// MulInt64s multiplies input1 by input2 and writes back the result into dst slice
-func MulInt64s(dst, input1, input2 []int64) []int64 {
-	return mul(dst, input1, input2)
+// MinOfUint64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfUint64s(dst, input1, input2 []uint64) []uint64 {
+	return minOf(dst, input1, input2)
 }
 
-// DivInt64s divides input1 by input2 and writes back the result into dst slice
-func DivInt64s(dst, input1, input2 []int64) []int64 {
-	return div(dst, input1, input2)
+// MaxOfUint64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfUint64s(dst, input1, input2 []uint64) []uint64 {
+	return maxOf(dst, input1, input2)
 }
 
-// ---------------------------------- Float32 ----------------------------------
+// MinOfScalarUint64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return minOfScalar(dst, input, value)
+}
 
-// SumFloat32s sums up all of the elements of the slice and returns the value
-func SumFloat32s(input []float32) (out float32) {
-	return sum(input)
+// MaxOfScalarUint64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarUint64s(dst, input []uint64, value uint64) []uint64 {
+	return maxOfScalar(dst, input, value)
 }
 
-// MinFloat32s returns the smallest element value in the slice
-func MinFloat32s(input []float32) (out float32) {
-	return min(input)
+// ClampUint64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUint64s(dst, input []uint64, lo, hi uint64) []uint64 {
+	return clamp(dst, input, lo, hi)
 }
 
-// MaxFloat32s returns the largest element value in the slice
-func MaxFloat32s(input []float32) (out float32) {
-	return max(input)
+// EqualsUint64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
 }
 
-// AddFloat32s adds input1 to input2 and writes back the result into dst slice
-func AddFloat32s(dst, input1, input2 []float32) []float32 {
-	return add(dst, input1, input2)
+// LessThanUint64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
 }
 
-// SubFloat32s subtracts input2 from input1 and writes back the result into dst slice
-func SubFloat32s(dst, input1, input2 []float32) []float32 {
-	return sub(dst, input1, input2)
+// GreaterThanUint64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUint64s(input []uint64, value uint64, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
 }
 
-// MulFloat32s multiplies input1 by input2 and writes back the result into dst slice
-func MulFloat32s(dst, input1, input2 []float32) []float32 {
-	return mul(dst, input1, input2)
+// BetweenUint64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUint64s(input []uint64, lo, hi uint64, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
 }
 
-// DivFloat32s divides input1 by input2 and writes back the result into dst slice
-func DivFloat32s(dst, input1, input2 []float32) []float32 {
-	return div(dst, input1, input2)
+// CompareUint64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUint64s(input1, input2 []uint64, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
 }
 
-// ---------------------------------- Float64 ----------------------------------
+// SumUint64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUint64sMasked(input []uint64, mask []uint64) uint64 {
+	return sumMasked(input, mask)
+}
 
-// SumFloat64s sums up all of the elements of the slice and returns the value
-func SumFloat64s(input []float64) (out float64) {
+// MinUint64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUint64sMasked(input []uint64, mask []uint64) (uint64, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxUint64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUint64sMasked(input []uint64, mask []uint64) (uint64, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressUint64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUint64s(dst, input []uint64, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectUint64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUint64s(dst, input1, input2 []uint64, mask []uint64) []uint64 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarUint64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUint64s(dst, input []uint64, mask []uint64, value uint64) []uint64 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int8 ----------------------------------
+
+// SumInt8s sums up all of the elements of the slice and returns the value
+func SumInt8s(input []int8) (out int8) {
+	return sum(input)
+}
+
+// SumInt8sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt8sToInt64(input []int8) (out int64) {
+	return sumWide[int64](input)
+}
+
+// MinInt8s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt8s(input []int8) (out int8) {
+	return min(input)
+}
+
+// MaxInt8s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt8s(input []int8) (out int8) {
+	return max(input)
+}
+
+// MinMaxInt8s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt8s(input []int8) (lo, hi int8) {
+	return minmax(input)
+}
+
+// ArgMinInt8s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt8s(input []int8) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxInt8s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt8s(input []int8) (out int) {
+	return argmax(input)
+}
+
+// DotInt8s computes the dot product of input1 and input2 and returns the value
+func DotInt8s(input1, input2 []int8) (out int8) {
+	return dot(input1, input2)
+}
+
+// AddInt8s adds input1 to input2 and writes back the result into dst slice
+func AddInt8s(dst, input1, input2 []int8) []int8 {
+	return add(dst, input1, input2)
+}
+
+// SubInt8s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt8s(dst, input1, input2 []int8) []int8 {
+	return sub(dst, input1, input2)
+}
+
+// MulInt8s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt8s(dst, input1, input2 []int8) []int8 {
+	return mul(dst, input1, input2)
+}
+
+// DivInt8s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt8s(dst, input1, input2 []int8) []int8 {
+	return div(dst, input1, input2)
+}
+
+// AddScalarInt8s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt8s(dst, input []int8, value int8) []int8 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarInt8s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt8s(dst, input []int8, value int8) []int8 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarInt8s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt8s(dst, input []int8, value int8) []int8 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarInt8s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt8s(dst, input []int8, value int8) []int8 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarInt8s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarInt8s(dst, input []int8, value int8) []int8 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarInt8s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt8s(dst, input []int8, value int8) []int8 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfInt8s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt8s(dst, input1, input2 []int8) []int8 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfInt8s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt8s(dst, input1, input2 []int8) []int8 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt8s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt8s(dst, input []int8, value int8) []int8 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt8s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt8s(dst, input []int8, value int8) []int8 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampInt8s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt8s(dst, input []int8, lo, hi int8) []int8 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsInt8s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanInt8s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt8s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt8s(input []int8, value int8, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenInt8s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt8s(input []int8, lo, hi int8, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareInt8s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt8s(input1, input2 []int8, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumInt8sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt8sMasked(input []int8, mask []uint64) int8 {
+	return sumMasked(input, mask)
+}
+
+// MinInt8sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt8sMasked(input []int8, mask []uint64) (int8, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxInt8sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt8sMasked(input []int8, mask []uint64) (int8, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressInt8s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt8s(dst, input []int8, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectInt8s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt8s(dst, input1, input2 []int8, mask []uint64) []int8 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt8s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt8s(dst, input []int8, mask []uint64, value int8) []int8 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int16 ----------------------------------
+
+// SumInt16s sums up all of the elements of the slice and returns the value
+func SumInt16s(input []int16) (out int16) {
+	return sum(input)
+}
+
+// SumInt16sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt16sToInt64(input []int16) (out int64) {
+	return sumWide[int64](input)
+}
+
+// MinInt16s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt16s(input []int16) (out int16) {
+	return min(input)
+}
+
+// MaxInt16s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt16s(input []int16) (out int16) {
+	return max(input)
+}
+
+// MinMaxInt16s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt16s(input []int16) (lo, hi int16) {
+	return minmax(input)
+}
+
+// ArgMinInt16s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt16s(input []int16) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxInt16s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt16s(input []int16) (out int) {
+	return argmax(input)
+}
+
+// DotInt16s computes the dot product of input1 and input2 and returns the value
+func DotInt16s(input1, input2 []int16) (out int16) {
+	return dot(input1, input2)
+}
+
+// AddInt16s adds input1 to input2 and writes back the result into dst slice
+func AddInt16s(dst, input1, input2 []int16) []int16 {
+	return add(dst, input1, input2)
+}
+
+// SubInt16s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt16s(dst, input1, input2 []int16) []int16 {
+	return sub(dst, input1, input2)
+}
+
+// MulInt16s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt16s(dst, input1, input2 []int16) []int16 {
+	return mul(dst, input1, input2)
+}
+
+// DivInt16s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt16s(dst, input1, input2 []int16) []int16 {
+	return div(dst, input1, input2)
+}
+
+// AddScalarInt16s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt16s(dst, input []int16, value int16) []int16 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarInt16s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt16s(dst, input []int16, value int16) []int16 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarInt16s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt16s(dst, input []int16, value int16) []int16 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarInt16s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt16s(dst, input []int16, value int16) []int16 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarInt16s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarInt16s(dst, input []int16, value int16) []int16 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarInt16s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt16s(dst, input []int16, value int16) []int16 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfInt16s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt16s(dst, input1, input2 []int16) []int16 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfInt16s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt16s(dst, input1, input2 []int16) []int16 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt16s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt16s(dst, input []int16, value int16) []int16 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt16s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt16s(dst, input []int16, value int16) []int16 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampInt16s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt16s(dst, input []int16, lo, hi int16) []int16 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsInt16s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanInt16s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt16s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt16s(input []int16, value int16, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenInt16s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt16s(input []int16, lo, hi int16, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareInt16s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt16s(input1, input2 []int16, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumInt16sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt16sMasked(input []int16, mask []uint64) int16 {
+	return sumMasked(input, mask)
+}
+
+// MinInt16sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt16sMasked(input []int16, mask []uint64) (int16, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxInt16sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt16sMasked(input []int16, mask []uint64) (int16, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressInt16s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt16s(dst, input []int16, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectInt16s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt16s(dst, input1, input2 []int16, mask []uint64) []int16 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt16s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt16s(dst, input []int16, mask []uint64, value int16) []int16 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int32 ----------------------------------
+
+// SumInt32s sums up all of the elements of the slice and returns the value
+func SumInt32s(input []int32) (out int32) {
+	return sum(input)
+}
+
+// SumInt32sToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumInt32sToInt64(input []int32) (out int64) {
+	return sumWide[int64](input)
+}
+
+// MinInt32s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt32s(input []int32) (out int32) {
+	return min(input)
+}
+
+// MaxInt32s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt32s(input []int32) (out int32) {
+	return max(input)
+}
+
+// MinMaxInt32s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt32s(input []int32) (lo, hi int32) {
+	return minmax(input)
+}
+
+// ArgMinInt32s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt32s(input []int32) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxInt32s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt32s(input []int32) (out int) {
+	return argmax(input)
+}
+
+// DotInt32s computes the dot product of input1 and input2 and returns the value
+func DotInt32s(input1, input2 []int32) (out int32) {
+	return dot(input1, input2)
+}
+
+// AddInt32s adds input1 to input2 and writes back the result into dst slice
+func AddInt32s(dst, input1, input2 []int32) []int32 {
+	return add(dst, input1, input2)
+}
+
+// SubInt32s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt32s(dst, input1, input2 []int32) []int32 {
+	return sub(dst, input1, input2)
+}
+
+// MulInt32s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt32s(dst, input1, input2 []int32) []int32 {
+	return mul(dst, input1, input2)
+}
+
+// DivInt32s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt32s(dst, input1, input2 []int32) []int32 {
+	return div(dst, input1, input2)
+}
+
+// AddScalarInt32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt32s(dst, input []int32, value int32) []int32 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarInt32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt32s(dst, input []int32, value int32) []int32 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarInt32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt32s(dst, input []int32, value int32) []int32 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarInt32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt32s(dst, input []int32, value int32) []int32 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarInt32s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarInt32s(dst, input []int32, value int32) []int32 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarInt32s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt32s(dst, input []int32, value int32) []int32 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfInt32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt32s(dst, input1, input2 []int32) []int32 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfInt32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt32s(dst, input1, input2 []int32) []int32 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt32s(dst, input []int32, value int32) []int32 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt32s(dst, input []int32, value int32) []int32 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampInt32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt32s(dst, input []int32, lo, hi int32) []int32 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsInt32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanInt32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt32s(input []int32, value int32, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenInt32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt32s(input []int32, lo, hi int32, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareInt32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt32s(input1, input2 []int32, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumInt32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt32sMasked(input []int32, mask []uint64) int32 {
+	return sumMasked(input, mask)
+}
+
+// MinInt32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt32sMasked(input []int32, mask []uint64) (int32, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxInt32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt32sMasked(input []int32, mask []uint64) (int32, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressInt32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt32s(dst, input []int32, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectInt32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt32s(dst, input1, input2 []int32, mask []uint64) []int32 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt32s(dst, input []int32, mask []uint64, value int32) []int32 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Int64 ----------------------------------
+
+// SumInt64s sums up all of the elements of the slice and returns the value
+func SumInt64s(input []int64) (out int64) {
+	return sum(input)
+}
+
+// MinInt64s returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInt64s(input []int64) (out int64) {
+	return min(input)
+}
+
+// MaxInt64s returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInt64s(input []int64) (out int64) {
+	return max(input)
+}
+
+// MinMaxInt64s returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInt64s(input []int64) (lo, hi int64) {
+	return minmax(input)
+}
+
+// ArgMinInt64s returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInt64s(input []int64) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxInt64s returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInt64s(input []int64) (out int) {
+	return argmax(input)
+}
+
+// DotInt64s computes the dot product of input1 and input2 and returns the value
+func DotInt64s(input1, input2 []int64) (out int64) {
+	return dot(input1, input2)
+}
+
+// AddInt64s adds input1 to input2 and writes back the result into dst slice
+func AddInt64s(dst, input1, input2 []int64) []int64 {
+	return add(dst, input1, input2)
+}
+
+// SubInt64s subtracts input2 from input1 and writes back the result into dst slice
+func SubInt64s(dst, input1, input2 []int64) []int64 {
+	return sub(dst, input1, input2)
+}
+
+// MulInt64s multiplies input1 by input2 and writes back the result into dst slice
+func MulInt64s(dst, input1, input2 []int64) []int64 {
+	return mul(dst, input1, input2)
+}
+
+// DivInt64s divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInt64s(dst, input1, input2 []int64) []int64 {
+	return div(dst, input1, input2)
+}
+
+// AddScalarInt64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarInt64s(dst, input []int64, value int64) []int64 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarInt64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInt64s(dst, input []int64, value int64) []int64 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarInt64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInt64s(dst, input []int64, value int64) []int64 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarInt64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInt64s(dst, input []int64, value int64) []int64 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarInt64s divides each element of the input by value and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if value is zero
+func DivScalarInt64s(dst, input []int64, value int64) []int64 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarInt64s divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInt64s(dst, input []int64, value int64) []int64 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfInt64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+func MinOfInt64s(dst, input1, input2 []int64) []int64 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfInt64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+func MaxOfInt64s(dst, input1, input2 []int64) []int64 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarInt64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above.
+func MinOfScalarInt64s(dst, input []int64, value int64) []int64 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarInt64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below.
+func MaxOfScalarInt64s(dst, input []int64, value int64) []int64 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampInt64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInt64s(dst, input []int64, lo, hi int64) []int64 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsInt64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanInt64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanInt64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInt64s(input []int64, value int64, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenInt64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInt64s(input []int64, lo, hi int64, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareInt64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInt64s(input1, input2 []int64, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumInt64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumInt64sMasked(input []int64, mask []uint64) int64 {
+	return sumMasked(input, mask)
+}
+
+// MinInt64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinInt64sMasked(input []int64, mask []uint64) (int64, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxInt64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxInt64sMasked(input []int64, mask []uint64) (int64, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressInt64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInt64s(dst, input []int64, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectInt64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInt64s(dst, input1, input2 []int64, mask []uint64) []int64 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarInt64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInt64s(dst, input []int64, mask []uint64, value int64) []int64 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// ---------------------------------- Float32 ----------------------------------
+
+// SumFloat32s sums up all of the elements of the slice and returns the value
+func SumFloat32s(input []float32) (out float32) {
+	return sum(input)
+}
+
+// SumFloat32sToFloat64 sums up all of the elements of the slice into a float64 accumulator and returns the value
+func SumFloat32sToFloat64(input []float32) (out float64) {
+	return sumWide[float64](input)
+}
+
+// MinFloat32s returns the smallest element value in the slice. The -0 is smaller
+// than +0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MinFloat32s(input []float32) (out float32) {
+	return min(input)
+}
+
+// MaxFloat32s returns the largest element value in the slice. The +0 is larger
+// than -0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
+func MaxFloat32s(input []float32) (out float32) {
+	return max(input)
+}
+
+// SumFloat32sKahan sums up all of the elements of the slice using compensated (Kahan) summation
+// and returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat32sKahan(input []float32) float32 {
+	return sumKahan(input)
+}
+
+// SumFloat32sPairwise sums up all of the elements of the slice using pairwise summation and
+// returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat32sPairwise(input []float32) float32 {
+	return sumPairwise(input)
+}
+
+// NanMinFloat32s returns the smallest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMinFloat32s(input []float32) (out float32) {
+	return nanmin(input)
+}
+
+// NanMaxFloat32s returns the largest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMaxFloat32s(input []float32) (out float32) {
+	return nanmax(input)
+}
+
+// MinMaxFloat32s returns both the smallest and the largest element values in the slice.
+// If any of the elements is NaN, both are NaN. It panics if the slice is empty.
+func MinMaxFloat32s(input []float32) (lo, hi float32) {
+	return minmax(input)
+}
+
+// ArgMinFloat32s returns the index of the smallest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMinFloat32s(input []float32) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxFloat32s returns the index of the largest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMaxFloat32s(input []float32) (out int) {
+	return argmax(input)
+}
+
+// DotFloat32s computes the dot product of input1 and input2 and returns the value
+func DotFloat32s(input1, input2 []float32) (out float32) {
+	return dot(input1, input2)
+}
+
+// AddFloat32s adds input1 to input2 and writes back the result into dst slice
+func AddFloat32s(dst, input1, input2 []float32) []float32 {
+	return add(dst, input1, input2)
+}
+
+// SubFloat32s subtracts input2 from input1 and writes back the result into dst slice
+func SubFloat32s(dst, input1, input2 []float32) []float32 {
+	return sub(dst, input1, input2)
+}
+
+// MulFloat32s multiplies input1 by input2 and writes back the result into dst slice
+func MulFloat32s(dst, input1, input2 []float32) []float32 {
+	return mul(dst, input1, input2)
+}
+
+// DivFloat32s divides input1 by input2 and writes back the result into dst slice
+func DivFloat32s(dst, input1, input2 []float32) []float32 {
+	return div(dst, input1, input2)
+}
+
+// AddScalarFloat32s adds value to each element of the input and writes back the result into dst slice
+func AddScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarFloat32s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarFloat32s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarFloat32s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarFloat32s divides each element of the input by value and writes back the result into dst slice
+func DivScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarFloat32s divides value by each element of the input and writes back the result into dst slice
+func RevDivScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfFloat32s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MinOfFloat32s(dst, input1, input2 []float32) []float32 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfFloat32s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MaxOfFloat32s(dst, input1, input2 []float32) []float32 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarFloat32s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above. If either the element or value is NaN, the result is NaN.
+func MinOfScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarFloat32s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below. If either the element or value is NaN, the result is NaN.
+func MaxOfScalarFloat32s(dst, input []float32, value float32) []float32 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampFloat32s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi. If either the element or a bound is NaN, the result is NaN.
+func ClampFloat32s(dst, input []float32, lo, hi float32) []float32 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsFloat32s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanFloat32s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanFloat32s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanFloat32s(input []float32, value float32, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenFloat32s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenFloat32s(input []float32, lo, hi float32, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareFloat32s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareFloat32s(input1, input2 []float32, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumFloat32sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumFloat32sMasked(input []float32, mask []uint64) float32 {
+	return sumMasked(input, mask)
+}
+
+// MinFloat32sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MinFloat32sMasked(input []float32, mask []uint64) (float32, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxFloat32sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MaxFloat32sMasked(input []float32, mask []uint64) (float32, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressFloat32s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressFloat32s(dst, input []float32, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectFloat32s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectFloat32s(dst, input1, input2 []float32, mask []uint64) []float32 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarFloat32s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarFloat32s(dst, input []float32, mask []uint64, value float32) []float32 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// FMAFloat32s multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func FMAFloat32s(dst, input1, input2, input3 []float32) []float32 {
+	return fmadd(dst, input1, input2, input3)
+}
+
+// AXPYFloat32s multiplies x by alpha, adds y and writes back the result into dst slice
+func AXPYFloat32s(dst []float32, alpha float32, x, y []float32) []float32 {
+	return axpy(dst, alpha, x, y)
+}
+
+// ---------------------------------- Float64 ----------------------------------
+
+// SumFloat64s sums up all of the elements of the slice and returns the value
+func SumFloat64s(input []float64) (out float64) {
 	return sum(input)
 }
 
-// MinFloat64s returns the smallest element value in the slice
+// MinFloat64s returns the smallest element value in the slice. The -0 is smaller
+// than +0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
 func MinFloat64s(input []float64) (out float64) {
 	return min(input)
 }
 
-// MaxFloat64s returns the largest element value in the slice
+// MaxFloat64s returns the largest element value in the slice. The +0 is larger
+// than -0, and if any of the elements is NaN, the result is NaN. It panics if the slice is empty.
 func MaxFloat64s(input []float64) (out float64) {
 	return max(input)
 }
 
+// SumFloat64sKahan sums up all of the elements of the slice using compensated (Kahan) summation
+// and returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat64sKahan(input []float64) float64 {
+	return sumKahan(input)
+}
+
+// SumFloat64sPairwise sums up all of the elements of the slice using pairwise summation and
+// returns the value. The order of additions is fixed, so the result is the same on every CPU.
+func SumFloat64sPairwise(input []float64) float64 {
+	return sumPairwise(input)
+}
+
+// NanMinFloat64s returns the smallest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMinFloat64s(input []float64) (out float64) {
+	return nanmin(input)
+}
+
+// NanMaxFloat64s returns the largest element value in the slice, ignoring NaNs. If all of the
+// elements are NaN, the result is NaN. It panics if the slice is empty.
+func NanMaxFloat64s(input []float64) (out float64) {
+	return nanmax(input)
+}
+
+// MinMaxFloat64s returns both the smallest and the largest element values in the slice.
+// If any of the elements is NaN, both are NaN. It panics if the slice is empty.
+func MinMaxFloat64s(input []float64) (lo, hi float64) {
+	return minmax(input)
+}
+
+// ArgMinFloat64s returns the index of the smallest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMinFloat64s(input []float64) (out int) {
+	return argmin(input)
+}
+
+// ArgMaxFloat64s returns the index of the largest element value in the slice.
+// If any of the elements is NaN, the index of the first NaN is returned. It panics if the slice is empty.
+func ArgMaxFloat64s(input []float64) (out int) {
+	return argmax(input)
+}
+
+// DotFloat64s computes the dot product of input1 and input2 and returns the value
+func DotFloat64s(input1, input2 []float64) (out float64) {
+	return dot(input1, input2)
+}
+
 // AddFloat64s adds input1 to input2 and writes back the result into dst slice
 func AddFloat64s(dst, input1, input2 []float64) []float64 {
 	return add(dst, input1, input2)
@@ -377,3 +1870,144 @@ func DivFloat64s(dst, input1, input2 []float64) []float64 {
 	return div(dst, input1, input2)
 }
 
+// AddScalarFloat64s adds value to each element of the input and writes back the result into dst slice
+func AddScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return addScalar(dst, input, value)
+}
+
+// SubScalarFloat64s subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return subScalar(dst, input, value)
+}
+
+// RevSubScalarFloat64s subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return rsubScalar(dst, input, value)
+}
+
+// MulScalarFloat64s multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return mulScalar(dst, input, value)
+}
+
+// DivScalarFloat64s divides each element of the input by value and writes back the result into dst slice
+func DivScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return divScalar(dst, input, value)
+}
+
+// RevDivScalarFloat64s divides value by each element of the input and writes back the result into dst slice
+func RevDivScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return rdivScalar(dst, input, value)
+}
+
+// MinOfFloat64s writes back the smaller of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MinOfFloat64s(dst, input1, input2 []float64) []float64 {
+	return minOf(dst, input1, input2)
+}
+
+// MaxOfFloat64s writes back the larger of each pair of elements of input1 and input2 into dst slice.
+// If either of the elements is NaN, the result is NaN.
+func MaxOfFloat64s(dst, input1, input2 []float64) []float64 {
+	return maxOf(dst, input1, input2)
+}
+
+// MinOfScalarFloat64s writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above. If either the element or value is NaN, the result is NaN.
+func MinOfScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return minOfScalar(dst, input, value)
+}
+
+// MaxOfScalarFloat64s writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below. If either the element or value is NaN, the result is NaN.
+func MaxOfScalarFloat64s(dst, input []float64, value float64) []float64 {
+	return maxOfScalar(dst, input, value)
+}
+
+// ClampFloat64s limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi. If either the element or a bound is NaN, the result is NaN.
+func ClampFloat64s(dst, input []float64, lo, hi float64) []float64 {
+	return clamp(dst, input, lo, hi)
+}
+
+// EqualsFloat64s sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	return equals(input, value, bitmap)
+}
+
+// LessThanFloat64s sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	return lessThan(input, value, bitmap)
+}
+
+// GreaterThanFloat64s sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanFloat64s(input []float64, value float64, bitmap []uint64) []uint64 {
+	return greaterThan(input, value, bitmap)
+}
+
+// BetweenFloat64s sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenFloat64s(input []float64, lo, hi float64, bitmap []uint64) []uint64 {
+	return between(input, lo, hi, bitmap)
+}
+
+// CompareFloat64s sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareFloat64s(input1, input2 []float64, op Comparison, bitmap []uint64) []uint64 {
+	return compare(input1, input2, op, bitmap)
+}
+
+// SumFloat64sMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumFloat64sMasked(input []float64, mask []uint64) float64 {
+	return sumMasked(input, mask)
+}
+
+// MinFloat64sMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MinFloat64sMasked(input []float64, mask []uint64) (float64, bool) {
+	return minMasked(input, mask)
+}
+
+// MaxFloat64sMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected. If any of the selected elements is NaN, the result is NaN.
+func MaxFloat64sMasked(input []float64, mask []uint64) (float64, bool) {
+	return maxMasked(input, mask)
+}
+
+// CompressFloat64s copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressFloat64s(dst, input []float64, mask []uint64) int {
+	return compress(dst, input, mask)
+}
+
+// SelectFloat64s writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectFloat64s(dst, input1, input2 []float64, mask []uint64) []float64 {
+	return blend(dst, input1, input2, mask)
+}
+
+// SelectScalarFloat64s writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarFloat64s(dst, input []float64, mask []uint64, value float64) []float64 {
+	return blendScalar(dst, input, mask, value)
+}
+
+// FMAFloat64s multiplies input1 by input2, adds input3 and writes back the result into dst slice
+func FMAFloat64s(dst, input1, input2, input3 []float64) []float64 {
+	return fmadd(dst, input1, input2, input3)
+}
+
+// AXPYFloat64s multiplies x by alpha, adds y and writes back the result into dst slice
+func AXPYFloat64s(dst []float64, alpha float64, x, y []float64) []float64 {
+	return axpy(dst, alpha, x, y)
+}
+
@@ -0,0 +1,609 @@
+// Copyright (c) Roman Atachiants and contributors. All rights reserved.
+// Licensed under the MIT license. See LICENSE file in the project root for details.
+
+package simd
+
+
+// ---------------------------------- Int ----------------------------------
+
+// SumInts sums up all of the elements of the slice and returns the value
+func SumInts(input []int) int {
+	if is64 {
+		return int(SumInt64s(as[int64](input)))
+	}
+	return int(SumInt32s(as[int32](input)))
+}
+
+// SumIntsToInt64 sums up all of the elements of the slice into a int64 accumulator and returns the value
+func SumIntsToInt64(input []int) int64 {
+	if is64 {
+		return SumInt64s(as[int64](input))
+	}
+	return SumInt32sToInt64(as[int32](input))
+}
+
+// MinInts returns the smallest element value in the slice. It panics if the slice is empty.
+func MinInts(input []int) int {
+	if is64 {
+		return int(MinInt64s(as[int64](input)))
+	}
+	return int(MinInt32s(as[int32](input)))
+}
+
+// MaxInts returns the largest element value in the slice. It panics if the slice is empty.
+func MaxInts(input []int) int {
+	if is64 {
+		return int(MaxInt64s(as[int64](input)))
+	}
+	return int(MaxInt32s(as[int32](input)))
+}
+
+// MinMaxInts returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxInts(input []int) (int, int) {
+	if is64 {
+		lo, hi := MinMaxInt64s(as[int64](input))
+		return int(lo), int(hi)
+	}
+	lo, hi := MinMaxInt32s(as[int32](input))
+	return int(lo), int(hi)
+}
+
+// ArgMinInts returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinInts(input []int) int {
+	if is64 {
+		return ArgMinInt64s(as[int64](input))
+	}
+	return ArgMinInt32s(as[int32](input))
+}
+
+// ArgMaxInts returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxInts(input []int) int {
+	if is64 {
+		return ArgMaxInt64s(as[int64](input))
+	}
+	return ArgMaxInt32s(as[int32](input))
+}
+
+// DotInts computes the dot product of input1 and input2 and returns the value
+func DotInts(input1, input2 []int) int {
+	if is64 {
+		return int(DotInt64s(as[int64](input1), as[int64](input2)))
+	}
+	return int(DotInt32s(as[int32](input1), as[int32](input2)))
+}
+
+// AddInts adds input1 to input2 and writes back the result into dst slice
+func AddInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](AddInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](AddInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// SubInts subtracts input2 from input1 and writes back the result into dst slice
+func SubInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](SubInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](SubInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// MulInts multiplies input1 by input2 and writes back the result into dst slice
+func MulInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](MulInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](MulInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// DivInts divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](DivInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](DivInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// AddScalarInts adds value to each element of the input and writes back the result into dst slice
+func AddScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](AddScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](AddScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// SubScalarInts subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](SubScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](SubScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// RevSubScalarInts subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](RevSubScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](RevSubScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// MulScalarInts multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](MulScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](MulScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// DivScalarInts divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](DivScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](DivScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// RevDivScalarInts divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](RevDivScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](RevDivScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// MinOfInts writes back the smaller of each pair of elements of input1 and input2 into dst slice
+func MinOfInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](MinOfInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](MinOfInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// MaxOfInts writes back the larger of each pair of elements of input1 and input2 into dst slice
+func MaxOfInts(dst, input1, input2 []int) []int {
+	if is64 {
+		return as[int](MaxOfInt64s(as[int64](dst), as[int64](input1), as[int64](input2)))
+	}
+	return as[int](MaxOfInt32s(as[int32](dst), as[int32](input1), as[int32](input2)))
+}
+
+// MinOfScalarInts writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above
+func MinOfScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](MinOfScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](MinOfScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// MaxOfScalarInts writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below
+func MaxOfScalarInts(dst, input []int, value int) []int {
+	if is64 {
+		return as[int](MaxOfScalarInt64s(as[int64](dst), as[int64](input), int64(value)))
+	}
+	return as[int](MaxOfScalarInt32s(as[int32](dst), as[int32](input), int32(value)))
+}
+
+// ClampInts limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampInts(dst, input []int, lo, hi int) []int {
+	if is64 {
+		return as[int](ClampInt64s(as[int64](dst), as[int64](input), int64(lo), int64(hi)))
+	}
+	return as[int](ClampInt32s(as[int32](dst), as[int32](input), int32(lo), int32(hi)))
+}
+
+// EqualsInts sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsInts(input []int, value int, bitmap []uint64) []uint64 {
+	if is64 {
+		return EqualsInt64s(as[int64](input), int64(value), bitmap)
+	}
+	return EqualsInt32s(as[int32](input), int32(value), bitmap)
+}
+
+// LessThanInts sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanInts(input []int, value int, bitmap []uint64) []uint64 {
+	if is64 {
+		return LessThanInt64s(as[int64](input), int64(value), bitmap)
+	}
+	return LessThanInt32s(as[int32](input), int32(value), bitmap)
+}
+
+// GreaterThanInts sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanInts(input []int, value int, bitmap []uint64) []uint64 {
+	if is64 {
+		return GreaterThanInt64s(as[int64](input), int64(value), bitmap)
+	}
+	return GreaterThanInt32s(as[int32](input), int32(value), bitmap)
+}
+
+// BetweenInts sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenInts(input []int, lo, hi int, bitmap []uint64) []uint64 {
+	if is64 {
+		return BetweenInt64s(as[int64](input), int64(lo), int64(hi), bitmap)
+	}
+	return BetweenInt32s(as[int32](input), int32(lo), int32(hi), bitmap)
+}
+
+// CompareInts sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareInts(input1, input2 []int, op Comparison, bitmap []uint64) []uint64 {
+	if is64 {
+		return CompareInt64s(as[int64](input1), as[int64](input2), op, bitmap)
+	}
+	return CompareInt32s(as[int32](input1), as[int32](input2), op, bitmap)
+}
+
+// SumIntsMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumIntsMasked(input []int, mask []uint64) int {
+	if is64 {
+		return int(SumInt64sMasked(as[int64](input), mask))
+	}
+	return int(SumInt32sMasked(as[int32](input), mask))
+}
+
+// MinIntsMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinIntsMasked(input []int, mask []uint64) (int, bool) {
+	if is64 {
+		value, ok := MinInt64sMasked(as[int64](input), mask)
+		return int(value), ok
+	}
+	value, ok := MinInt32sMasked(as[int32](input), mask)
+	return int(value), ok
+}
+
+// MaxIntsMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxIntsMasked(input []int, mask []uint64) (int, bool) {
+	if is64 {
+		value, ok := MaxInt64sMasked(as[int64](input), mask)
+		return int(value), ok
+	}
+	value, ok := MaxInt32sMasked(as[int32](input), mask)
+	return int(value), ok
+}
+
+// CompressInts copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressInts(dst, input []int, mask []uint64) int {
+	if is64 {
+		return CompressInt64s(as[int64](dst), as[int64](input), mask)
+	}
+	return CompressInt32s(as[int32](dst), as[int32](input), mask)
+}
+
+// SelectInts writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectInts(dst, input1, input2 []int, mask []uint64) []int {
+	if is64 {
+		return as[int](SelectInt64s(as[int64](dst), as[int64](input1), as[int64](input2), mask))
+	}
+	return as[int](SelectInt32s(as[int32](dst), as[int32](input1), as[int32](input2), mask))
+}
+
+// SelectScalarInts writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarInts(dst, input []int, mask []uint64, value int) []int {
+	if is64 {
+		return as[int](SelectScalarInt64s(as[int64](dst), as[int64](input), mask, int64(value)))
+	}
+	return as[int](SelectScalarInt32s(as[int32](dst), as[int32](input), mask, int32(value)))
+}
+
+// ---------------------------------- Uint ----------------------------------
+
+// SumUints sums up all of the elements of the slice and returns the value
+func SumUints(input []uint) uint {
+	if is64 {
+		return uint(SumUint64s(as[uint64](input)))
+	}
+	return uint(SumUint32s(as[uint32](input)))
+}
+
+// SumUintsToUint64 sums up all of the elements of the slice into a uint64 accumulator and returns the value
+func SumUintsToUint64(input []uint) uint64 {
+	if is64 {
+		return SumUint64s(as[uint64](input))
+	}
+	return SumUint32sToUint64(as[uint32](input))
+}
+
+// MinUints returns the smallest element value in the slice. It panics if the slice is empty.
+func MinUints(input []uint) uint {
+	if is64 {
+		return uint(MinUint64s(as[uint64](input)))
+	}
+	return uint(MinUint32s(as[uint32](input)))
+}
+
+// MaxUints returns the largest element value in the slice. It panics if the slice is empty.
+func MaxUints(input []uint) uint {
+	if is64 {
+		return uint(MaxUint64s(as[uint64](input)))
+	}
+	return uint(MaxUint32s(as[uint32](input)))
+}
+
+// MinMaxUints returns both the smallest and the largest element values in the slice. It panics if the slice is empty.
+func MinMaxUints(input []uint) (uint, uint) {
+	if is64 {
+		lo, hi := MinMaxUint64s(as[uint64](input))
+		return uint(lo), uint(hi)
+	}
+	lo, hi := MinMaxUint32s(as[uint32](input))
+	return uint(lo), uint(hi)
+}
+
+// ArgMinUints returns the index of the smallest element value in the slice. It panics if the slice is empty.
+func ArgMinUints(input []uint) int {
+	if is64 {
+		return ArgMinUint64s(as[uint64](input))
+	}
+	return ArgMinUint32s(as[uint32](input))
+}
+
+// ArgMaxUints returns the index of the largest element value in the slice. It panics if the slice is empty.
+func ArgMaxUints(input []uint) int {
+	if is64 {
+		return ArgMaxUint64s(as[uint64](input))
+	}
+	return ArgMaxUint32s(as[uint32](input))
+}
+
+// DotUints computes the dot product of input1 and input2 and returns the value
+func DotUints(input1, input2 []uint) uint {
+	if is64 {
+		return uint(DotUint64s(as[uint64](input1), as[uint64](input2)))
+	}
+	return uint(DotUint32s(as[uint32](input1), as[uint32](input2)))
+}
+
+// AddUints adds input1 to input2 and writes back the result into dst slice
+func AddUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](AddUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](AddUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// SubUints subtracts input2 from input1 and writes back the result into dst slice
+func SubUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](SubUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](SubUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// MulUints multiplies input1 by input2 and writes back the result into dst slice
+func MulUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](MulUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](MulUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// DivUints divides input1 by input2 and writes back the result into dst slice. It panics
+// with a *DivideByZeroError if any of the divisors is zero
+func DivUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](DivUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](DivUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// AddScalarUints adds value to each element of the input and writes back the result into dst slice
+func AddScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](AddScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](AddScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// SubScalarUints subtracts value from each element of the input and writes back the result into dst slice
+func SubScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](SubScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](SubScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// RevSubScalarUints subtracts each element of the input from value and writes back the result into dst slice
+func RevSubScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](RevSubScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](RevSubScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// MulScalarUints multiplies each element of the input by value and writes back the result into dst slice
+func MulScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](MulScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](MulScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// DivScalarUints divides each element of the input by value and writes back the result into dst slice.
+// It multiplies by the reciprocal of value instead, and panics with a *DivideByZeroError if value is zero
+func DivScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](DivScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](DivScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// RevDivScalarUints divides value by each element of the input and writes back the result into dst slice.
+// It panics with a *DivideByZeroError if any of the elements is zero
+func RevDivScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](RevDivScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](RevDivScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// MinOfUints writes back the smaller of each pair of elements of input1 and input2 into dst slice
+func MinOfUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](MinOfUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](MinOfUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// MaxOfUints writes back the larger of each pair of elements of input1 and input2 into dst slice
+func MaxOfUints(dst, input1, input2 []uint) []uint {
+	if is64 {
+		return as[uint](MaxOfUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2)))
+	}
+	return as[uint](MaxOfUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2)))
+}
+
+// MinOfScalarUints writes back the smaller of each element of the input and value into dst slice, which
+// clamps the input from above
+func MinOfScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](MinOfScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](MinOfScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// MaxOfScalarUints writes back the larger of each element of the input and value into dst slice, which
+// clamps the input from below
+func MaxOfScalarUints(dst, input []uint, value uint) []uint {
+	if is64 {
+		return as[uint](MaxOfScalarUint64s(as[uint64](dst), as[uint64](input), uint64(value)))
+	}
+	return as[uint](MaxOfScalarUint32s(as[uint32](dst), as[uint32](input), uint32(value)))
+}
+
+// ClampUints limits each element of the input to the [lo, hi] range and writes back the result into dst slice.
+// If lo is greater than hi, every element becomes hi.
+func ClampUints(dst, input []uint, lo, hi uint) []uint {
+	if is64 {
+		return as[uint](ClampUint64s(as[uint64](dst), as[uint64](input), uint64(lo), uint64(hi)))
+	}
+	return as[uint](ClampUint32s(as[uint32](dst), as[uint32](input), uint32(lo), uint32(hi)))
+}
+
+// EqualsUints sets a bit in the bitmap for each element of the input which is equal to value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func EqualsUints(input []uint, value uint, bitmap []uint64) []uint64 {
+	if is64 {
+		return EqualsUint64s(as[uint64](input), uint64(value), bitmap)
+	}
+	return EqualsUint32s(as[uint32](input), uint32(value), bitmap)
+}
+
+// LessThanUints sets a bit in the bitmap for each element of the input which is less than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func LessThanUints(input []uint, value uint, bitmap []uint64) []uint64 {
+	if is64 {
+		return LessThanUint64s(as[uint64](input), uint64(value), bitmap)
+	}
+	return LessThanUint32s(as[uint32](input), uint32(value), bitmap)
+}
+
+// GreaterThanUints sets a bit in the bitmap for each element of the input which is greater than value,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func GreaterThanUints(input []uint, value uint, bitmap []uint64) []uint64 {
+	if is64 {
+		return GreaterThanUint64s(as[uint64](input), uint64(value), bitmap)
+	}
+	return GreaterThanUint32s(as[uint32](input), uint32(value), bitmap)
+}
+
+// BetweenUints sets a bit in the bitmap for each element of the input which is within the [lo, hi] range,
+// and clears it otherwise. It processes as many elements as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func BetweenUints(input []uint, lo, hi uint, bitmap []uint64) []uint64 {
+	if is64 {
+		return BetweenUint64s(as[uint64](input), uint64(lo), uint64(hi), bitmap)
+	}
+	return BetweenUint32s(as[uint32](input), uint32(lo), uint32(hi), bitmap)
+}
+
+// CompareUints sets a bit in the bitmap for each pair of elements of input1 and input2 for which the comparison
+// holds, and clears it otherwise. It processes as many pairs as fit into the bitmap, and returns the bitmap
+// truncated to the words written, with the unused bits of the last word cleared.
+func CompareUints(input1, input2 []uint, op Comparison, bitmap []uint64) []uint64 {
+	if is64 {
+		return CompareUint64s(as[uint64](input1), as[uint64](input2), op, bitmap)
+	}
+	return CompareUint32s(as[uint32](input1), as[uint32](input2), op, bitmap)
+}
+
+// SumUintsMasked sums up the elements of the slice whose bit is set in the mask and returns the value.
+// Elements beyond the end of the mask are not selected.
+func SumUintsMasked(input []uint, mask []uint64) uint {
+	if is64 {
+		return uint(SumUint64sMasked(as[uint64](input), mask))
+	}
+	return uint(SumUint32sMasked(as[uint32](input), mask))
+}
+
+// MinUintsMasked returns the smallest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MinUintsMasked(input []uint, mask []uint64) (uint, bool) {
+	if is64 {
+		value, ok := MinUint64sMasked(as[uint64](input), mask)
+		return uint(value), ok
+	}
+	value, ok := MinUint32sMasked(as[uint32](input), mask)
+	return uint(value), ok
+}
+
+// MaxUintsMasked returns the largest element value of the slice among those whose bit is set in the mask,
+// or false if none of them is selected.
+func MaxUintsMasked(input []uint, mask []uint64) (uint, bool) {
+	if is64 {
+		value, ok := MaxUint64sMasked(as[uint64](input), mask)
+		return uint(value), ok
+	}
+	value, ok := MaxUint32sMasked(as[uint32](input), mask)
+	return uint(value), ok
+}
+
+// CompressUints copies the elements of the input whose bit is set in the mask next to each other into dst
+// slice, and returns the number of elements copied. It stops once dst is full.
+func CompressUints(dst, input []uint, mask []uint64) int {
+	if is64 {
+		return CompressUint64s(as[uint64](dst), as[uint64](input), mask)
+	}
+	return CompressUint32s(as[uint32](dst), as[uint32](input), mask)
+}
+
+// SelectUints writes back the element of input1 into dst slice where its bit is set in the mask, and the
+// element of input2 otherwise. Elements beyond the end of the mask are not written.
+func SelectUints(dst, input1, input2 []uint, mask []uint64) []uint {
+	if is64 {
+		return as[uint](SelectUint64s(as[uint64](dst), as[uint64](input1), as[uint64](input2), mask))
+	}
+	return as[uint](SelectUint32s(as[uint32](dst), as[uint32](input1), as[uint32](input2), mask))
+}
+
+// SelectScalarUints writes back the element of the input into dst slice where its bit is set in the mask,
+// and the value otherwise. Elements beyond the end of the mask are not written.
+func SelectScalarUints(dst, input []uint, mask []uint64, value uint) []uint {
+	if is64 {
+		return as[uint](SelectScalarUint64s(as[uint64](dst), as[uint64](input), mask, uint64(value)))
+	}
+	return as[uint](SelectScalarUint32s(as[uint32](dst), as[uint32](input), mask, uint32(value)))
+}
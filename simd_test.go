@@ -5,6 +5,8 @@ package simd
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"testing"
 	"time"
 
@@ -59,11 +61,32 @@ func measure(b *testing.B, name, mode string, fn func(b *testing.B)) float64 {
 	return float64(time.Since(start)) / float64(ops)
 }
 
-// Iterates over all modes
+// tiers lists all of the instruction sets, in the order of preference
+var tiers = []Level{AVX512, AVX2, SSE, Generic}
+
+// Iterates over all modes supported by the host
 func rangeModes(fn func(mode string)) {
-	for _, mode := range []string{"base", "simd"} {
-		setMode(mode)
-		fn(mode)
+	defer setMode("simd")
+	for _, tier := range tiers {
+		if tier <= supported {
+			setMode(tier.String())
+			fn(tier.String())
+		}
+	}
+}
+
+// rangeTiers runs the test for each of the tiers, skipping the ones the host doesn't support
+func rangeTiers(t *testing.T, fn func(t *testing.T)) {
+	defer setMode("simd")
+	for _, tier := range tiers {
+		t.Run(tier.String(), func(t *testing.T) {
+			if tier > supported {
+				t.Skipf("%s is not supported by the host", tier)
+			}
+
+			SetLevel(tier)
+			fn(t)
+		})
 	}
 }
 
@@ -71,12 +94,90 @@ func rangeModes(fn func(mode string)) {
 func setMode(mode string) {
 	switch mode {
 	case "simd":
-		avx2 = true
+		SetLevel(supported)
 	case "base":
-		avx2 = false
+		SetLevel(Generic)
+	default:
+		level, _ := parseLevel(mode)
+		SetLevel(level)
 	}
 }
 
+type price float32
+
+type level int16
+
+func TestNamedTypes(t *testing.T) {
+	assert.Equal(t, price(3), Sum([]price{1, 2}))
+	assert.Equal(t, price(1), Min([]price{3, 1, 2}))
+	assert.Equal(t, price(3), Max([]price{3, 1, 2}))
+	assert.Equal(t, 1, ArgMin([]price{3, 1, 2}))
+	assert.Equal(t, price(11), Dot([]price{1, 2}, []price{3, 4}))
+	assert.Equal(t, []price{4, 6}, Add(make([]price, 2), []price{1, 2}, []price{3, 4}))
+	assert.Equal(t, []price{5, 11}, FMA(make([]price, 2), []price{1, 2}, []price{3, 4}, []price{2, 3}))
+	assert.Equal(t, level(3), Sum([]level{1, 2}))
+	assert.Equal(t, int64(65534), SumWide[int64]([]level{32767, 32767}))
+	assert.Equal(t, []level{2, 2}, Sub(make([]level, 2), []level{3, 4}, []level{1, 2}))
+}
+
+func TestKindOf(t *testing.T) {
+	assert.Equal(t, reflect.Float32, kindOf[price]())
+	assert.Equal(t, reflect.Int16, kindOf[level]())
+	assert.Equal(t, reflect.Int8, kindOf[int8]())
+	assert.Equal(t, reflect.Int16, kindOf[int16]())
+	assert.Equal(t, reflect.Int32, kindOf[int32]())
+	assert.Equal(t, reflect.Int64, kindOf[int64]())
+	assert.Equal(t, reflect.Uint8, kindOf[uint8]())
+	assert.Equal(t, reflect.Uint16, kindOf[uint16]())
+	assert.Equal(t, reflect.Uint32, kindOf[uint32]())
+	assert.Equal(t, reflect.Uint64, kindOf[uint64]())
+	assert.Equal(t, reflect.Float32, kindOf[float32]())
+	assert.Equal(t, reflect.Float64, kindOf[float64]())
+	if is64 {
+		assert.Equal(t, reflect.Int64, kindOf[int]())
+		assert.Equal(t, reflect.Uint64, kindOf[uint]())
+	} else {
+		assert.Equal(t, reflect.Int32, kindOf[int]())
+		assert.Equal(t, reflect.Uint32, kindOf[uint]())
+	}
+}
+
+func TestNamedTypesKernel(t *testing.T) {
+	input := make([]price, 1000)
+	for i := range input {
+		input[i] = price(1) / price(i+1)
+	}
+
+	// The order of additions depends on the kernel, so only the typed one gives the same bits
+	rangeModes(func(mode string) {
+		assert.Equal(t, SumFloat32s(as[float32](input)), float32(Sum(input)), mode)
+		assert.Equal(t, MinFloat32s(as[float32](input)), float32(Min(input)), mode)
+	})
+}
+
+func TestPlatformTypes(t *testing.T) {
+	rangeModes(func(mode string) {
+		ints, uints := makeVector[int](1000), makeVector[uint](1000)
+		assert.Equal(t, sum(ints), SumInts(ints), mode)
+		assert.Equal(t, sum(uints), SumUints(uints), mode)
+		assert.Equal(t, sumWide[int64](ints), SumIntsToInt64(ints), mode)
+		assert.Equal(t, min(ints), MinInts(ints), mode)
+		assert.Equal(t, max(uints), MaxUints(uints), mode)
+		assert.Equal(t, argmin(ints), ArgMinInts(ints), mode)
+		assert.Equal(t, argmax(uints), ArgMaxUints(uints), mode)
+		assert.Equal(t, dot(ints, ints), DotInts(ints, ints), mode)
+		assert.Equal(t, add(make([]int, 1000), ints, ints), AddInts(make([]int, 1000), ints, ints), mode)
+		assert.Equal(t, mul(make([]uint, 1000), uints, uints), MulUints(make([]uint, 1000), uints, uints), mode)
+		assert.Equal(t, subScalar(make([]int, 1000), ints, 5), SubScalarInts(make([]int, 1000), ints, 5), mode)
+
+		lo, hi := MinMaxUints(uints)
+		assert.Equal(t, uint(1), lo, mode)
+		assert.Equal(t, uint(100), hi, mode)
+		assert.Equal(t, []int{-2, 3}, Sub(make([]int, 2), []int{1, 5}, []int{3, 2}), mode)
+		assert.Equal(t, []uint{3, 2}, Div(make([]uint, 2), []uint{9, 8}, []uint{3, 4}), mode)
+	})
+}
+
 func TestSum(t *testing.T) {
 	assert.Equal(t, 3, int(Sum([]int8{1, 2})))
 	assert.Equal(t, 3, int(Sum([]int16{1, 2})))
@@ -89,6 +190,122 @@ func TestSum(t *testing.T) {
 	assert.Equal(t, 3, int(Sum([]float32{1, 2})))
 	assert.Equal(t, 3, int(Sum([]float64{1, 2})))
 	assert.Equal(t, 3, int(Sum([]int{1, 2})))
+	assert.Equal(t, 3, int(Sum([]uint{1, 2})))
+}
+
+func TestSumWide(t *testing.T) {
+	assert.Equal(t, int64(-256), SumWide[int64]([]int8{-128, -128}))
+	assert.Equal(t, int64(65534), SumWide[int64]([]int16{32767, 32767}))
+	assert.Equal(t, int64(4294967294), SumWide[int64]([]int32{2147483647, 2147483647}))
+	assert.Equal(t, int64(3), SumWide[int64]([]int64{1, 2}))
+	assert.Equal(t, uint64(510), SumWide[uint64]([]uint8{255, 255}))
+	assert.Equal(t, uint64(131070), SumWide[uint64]([]uint16{65535, 65535}))
+	assert.Equal(t, uint64(8589934590), SumWide[uint64]([]uint32{4294967295, 4294967295}))
+	assert.Equal(t, uint64(3), SumWide[uint64]([]uint64{1, 2}))
+	assert.Equal(t, float64(3), SumWide[float64]([]float32{1, 2}))
+	assert.Equal(t, float64(3), SumWide[float64]([]float64{1, 2}))
+	assert.Equal(t, int64(3), SumWide[int64]([]int{1, 2}))
+	assert.Equal(t, uint64(3), SumWide[uint64]([]uint{1, 2}))
+}
+
+func TestSumWideKernel(t *testing.T) {
+	rangeTiers(t, func(t *testing.T) {
+		// The largest size makes the 16-bit kernels widen their lanes more than once on every tier
+		for _, size := range []int{1, 15, 16, 17, 63, 64, 65, 255, 256, 257, 1000, 1<<22 + 77} {
+			testSumWide[int64, int8](t, size, math.MinInt8, math.MaxInt8)
+			testSumWide[int64, int16](t, size, math.MinInt16, math.MaxInt16)
+			testSumWide[uint64, uint8](t, size, 0, math.MaxUint8)
+			testSumWide[uint64, uint16](t, size, 0, math.MaxUint16)
+		}
+	})
+}
+
+// testSumWide checks the widening sum of the smallest, the largest and of mixed values
+func testSumWide[W, T Integer](t *testing.T, size int, lo, hi T) {
+	for _, fill := range []func(i int) T{
+		func(int) T { return lo },
+		func(int) T { return hi },
+		func(i int) T { return T(i * 7919) },
+	} {
+		input := make([]T, size)
+		for i := range input {
+			input[i] = fill(i)
+		}
+
+		assert.Equal(t, sumWide[W](input), SumWide[W](input), "size=%d", size)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	assert.Equal(t, []int8{4, 6}, Add(make([]int8, 2), []int8{1, 2}, []int8{3, 4}))
+	assert.Equal(t, []int16{4, 6}, Add(make([]int16, 2), []int16{1, 2}, []int16{3, 4}))
+	assert.Equal(t, []int32{4, 6}, Add(make([]int32, 2), []int32{1, 2}, []int32{3, 4}))
+	assert.Equal(t, []int64{4, 6}, Add(make([]int64, 2), []int64{1, 2}, []int64{3, 4}))
+	assert.Equal(t, []uint8{4, 6}, Add(make([]uint8, 2), []uint8{1, 2}, []uint8{3, 4}))
+	assert.Equal(t, []uint16{4, 6}, Add(make([]uint16, 2), []uint16{1, 2}, []uint16{3, 4}))
+	assert.Equal(t, []uint32{4, 6}, Add(make([]uint32, 2), []uint32{1, 2}, []uint32{3, 4}))
+	assert.Equal(t, []uint64{4, 6}, Add(make([]uint64, 2), []uint64{1, 2}, []uint64{3, 4}))
+	assert.Equal(t, []float32{4, 6}, Add(make([]float32, 2), []float32{1, 2}, []float32{3, 4}))
+	assert.Equal(t, []float64{4, 6}, Add(make([]float64, 2), []float64{1, 2}, []float64{3, 4}))
+	assert.Equal(t, []int{4, 6}, Add(make([]int, 2), []int{1, 2}, []int{3, 4}))
+}
+
+func TestSub(t *testing.T) {
+	assert.Equal(t, []int8{2, 2}, Sub(make([]int8, 2), []int8{3, 4}, []int8{1, 2}))
+	assert.Equal(t, []int16{2, 2}, Sub(make([]int16, 2), []int16{3, 4}, []int16{1, 2}))
+	assert.Equal(t, []int32{2, 2}, Sub(make([]int32, 2), []int32{3, 4}, []int32{1, 2}))
+	assert.Equal(t, []int64{2, 2}, Sub(make([]int64, 2), []int64{3, 4}, []int64{1, 2}))
+	assert.Equal(t, []uint8{2, 2}, Sub(make([]uint8, 2), []uint8{3, 4}, []uint8{1, 2}))
+	assert.Equal(t, []uint16{2, 2}, Sub(make([]uint16, 2), []uint16{3, 4}, []uint16{1, 2}))
+	assert.Equal(t, []uint32{2, 2}, Sub(make([]uint32, 2), []uint32{3, 4}, []uint32{1, 2}))
+	assert.Equal(t, []uint64{2, 2}, Sub(make([]uint64, 2), []uint64{3, 4}, []uint64{1, 2}))
+	assert.Equal(t, []float32{2, 2}, Sub(make([]float32, 2), []float32{3, 4}, []float32{1, 2}))
+	assert.Equal(t, []float64{2, 2}, Sub(make([]float64, 2), []float64{3, 4}, []float64{1, 2}))
+	assert.Equal(t, []int{2, 2}, Sub(make([]int, 2), []int{3, 4}, []int{1, 2}))
+}
+
+func TestMul(t *testing.T) {
+	assert.Equal(t, []int8{3, 8}, Mul(make([]int8, 2), []int8{1, 2}, []int8{3, 4}))
+	assert.Equal(t, []int16{3, 8}, Mul(make([]int16, 2), []int16{1, 2}, []int16{3, 4}))
+	assert.Equal(t, []int32{3, 8}, Mul(make([]int32, 2), []int32{1, 2}, []int32{3, 4}))
+	assert.Equal(t, []int64{3, 8}, Mul(make([]int64, 2), []int64{1, 2}, []int64{3, 4}))
+	assert.Equal(t, []uint8{3, 8}, Mul(make([]uint8, 2), []uint8{1, 2}, []uint8{3, 4}))
+	assert.Equal(t, []uint16{3, 8}, Mul(make([]uint16, 2), []uint16{1, 2}, []uint16{3, 4}))
+	assert.Equal(t, []uint32{3, 8}, Mul(make([]uint32, 2), []uint32{1, 2}, []uint32{3, 4}))
+	assert.Equal(t, []uint64{3, 8}, Mul(make([]uint64, 2), []uint64{1, 2}, []uint64{3, 4}))
+	assert.Equal(t, []float32{3, 8}, Mul(make([]float32, 2), []float32{1, 2}, []float32{3, 4}))
+	assert.Equal(t, []float64{3, 8}, Mul(make([]float64, 2), []float64{1, 2}, []float64{3, 4}))
+	assert.Equal(t, []int{3, 8}, Mul(make([]int, 2), []int{1, 2}, []int{3, 4}))
+}
+
+func TestDiv(t *testing.T) {
+	assert.Equal(t, []int8{3, 2}, Div(make([]int8, 2), []int8{6, 8}, []int8{2, 4}))
+	assert.Equal(t, []int16{3, 2}, Div(make([]int16, 2), []int16{6, 8}, []int16{2, 4}))
+	assert.Equal(t, []int32{3, 2}, Div(make([]int32, 2), []int32{6, 8}, []int32{2, 4}))
+	assert.Equal(t, []int64{3, 2}, Div(make([]int64, 2), []int64{6, 8}, []int64{2, 4}))
+	assert.Equal(t, []uint8{3, 2}, Div(make([]uint8, 2), []uint8{6, 8}, []uint8{2, 4}))
+	assert.Equal(t, []uint16{3, 2}, Div(make([]uint16, 2), []uint16{6, 8}, []uint16{2, 4}))
+	assert.Equal(t, []uint32{3, 2}, Div(make([]uint32, 2), []uint32{6, 8}, []uint32{2, 4}))
+	assert.Equal(t, []uint64{3, 2}, Div(make([]uint64, 2), []uint64{6, 8}, []uint64{2, 4}))
+	assert.Equal(t, []float32{3, 2}, Div(make([]float32, 2), []float32{6, 8}, []float32{2, 4}))
+	assert.Equal(t, []float64{3, 2}, Div(make([]float64, 2), []float64{6, 8}, []float64{2, 4}))
+	assert.Equal(t, []int{3, 2}, Div(make([]int, 2), []int{6, 8}, []int{2, 4}))
+}
+
+func TestFMA(t *testing.T) {
+	defer func(v bool) {
+		fma = v
+		dispatch()
+	}(fma)
+
+	for _, mode := range []bool{true, false} {
+		fma = mode
+		dispatch()
+		assert.Equal(t, []float32{5, 11}, FMA(make([]float32, 2), []float32{1, 2}, []float32{3, 4}, []float32{2, 3}))
+		assert.Equal(t, []float64{5, 11}, FMA(make([]float64, 2), []float64{1, 2}, []float64{3, 4}, []float64{2, 3}))
+		assert.Equal(t, []float32{5, 7}, AXPY(make([]float32, 2), 2, []float32{1, 2}, []float32{3, 3}))
+		assert.Equal(t, []float64{5, 7}, AXPY(make([]float64, 2), 2, []float64{1, 2}, []float64{3, 3}))
+	}
 }
 
 func TestMin(t *testing.T) {
@@ -118,3 +335,164 @@ func TestMax(t *testing.T) {
 	assert.Equal(t, 2, int(Max([]float64{1, 2})))
 	assert.Equal(t, 2, int(Max([]int{1, 2})))
 }
+
+func TestNanMinMax(t *testing.T) {
+	nan := math.NaN()
+	assert.Equal(t, 1, int(NanMin([]int8{3, 1, 2})))
+	assert.Equal(t, 3, int(NanMax([]uint32{3, 1, 2})))
+	assert.Equal(t, 1, int(NanMin([]float32{3, float32(nan), 1, 2})))
+	assert.Equal(t, 3, int(NanMax([]float32{3, float32(nan), 1, 2})))
+	assert.Equal(t, 1, int(NanMin([]float64{nan, 3, 1, 2})))
+	assert.Equal(t, 3, int(NanMax([]float64{nan, 3, 1, 2})))
+	assert.Equal(t, price(1), NanMin([]price{3, price(nan), 1}))
+	assert.True(t, math.IsNaN(NanMin([]float64{nan, nan})))
+	assert.True(t, math.IsNaN(Min([]float64{3, nan, 1})))
+	assert.True(t, math.IsNaN(Max([]float64{3, nan, 1})))
+}
+
+func TestMinMax(t *testing.T) {
+	assertMinMax(t, []int8{3, 1, 2})
+	assertMinMax(t, []int16{3, 1, 2})
+	assertMinMax(t, []int32{3, 1, 2})
+	assertMinMax(t, []int64{3, 1, 2})
+	assertMinMax(t, []uint8{3, 1, 2})
+	assertMinMax(t, []uint16{3, 1, 2})
+	assertMinMax(t, []uint32{3, 1, 2})
+	assertMinMax(t, []uint64{3, 1, 2})
+	assertMinMax(t, []float32{3, 1, 2})
+	assertMinMax(t, []float64{3, 1, 2})
+	assertMinMax(t, []int{3, 1, 2})
+}
+
+func assertMinMax[T Number](t *testing.T, input []T) {
+	lo, hi := MinMax(input)
+	assert.Equal(t, 1, int(lo))
+	assert.Equal(t, 3, int(hi))
+}
+
+func TestArgMin(t *testing.T) {
+	assert.Equal(t, 1, ArgMin([]int8{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]int16{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]int32{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]int64{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]uint8{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]uint16{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]uint32{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]uint64{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]float32{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]float64{3, 1, 2, 1}))
+	assert.Equal(t, 1, ArgMin([]int{3, 1, 2, 1}))
+}
+
+func TestArgMax(t *testing.T) {
+	assert.Equal(t, 1, ArgMax([]int8{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]int16{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]int32{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]int64{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]uint8{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]uint16{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]uint32{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]uint64{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]float32{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]float64{1, 3, 2, 3}))
+	assert.Equal(t, 1, ArgMax([]int{1, 3, 2, 3}))
+}
+
+func TestDot(t *testing.T) {
+	assert.Equal(t, 11, int(Dot([]int8{1, 2}, []int8{3, 4})))
+	assert.Equal(t, 11, int(Dot([]int16{1, 2}, []int16{3, 4})))
+	assert.Equal(t, 11, int(Dot([]int32{1, 2}, []int32{3, 4})))
+	assert.Equal(t, 11, int(Dot([]int64{1, 2}, []int64{3, 4})))
+	assert.Equal(t, 11, int(Dot([]uint8{1, 2}, []uint8{3, 4})))
+	assert.Equal(t, 11, int(Dot([]uint16{1, 2}, []uint16{3, 4})))
+	assert.Equal(t, 11, int(Dot([]uint32{1, 2}, []uint32{3, 4})))
+	assert.Equal(t, 11, int(Dot([]uint64{1, 2}, []uint64{3, 4})))
+	assert.Equal(t, 11, int(Dot([]float32{1, 2}, []float32{3, 4})))
+	assert.Equal(t, 11, int(Dot([]float64{1, 2}, []float64{3, 4})))
+	assert.Equal(t, 11, int(Dot([]int{1, 2}, []int{3, 4})))
+}
+
+func TestLevel(t *testing.T) {
+	defer SetLevel(Features())
+
+	assert.Equal(t, Generic, SetLevel(Generic))
+	assert.Equal(t, Generic, Features())
+	assert.Equal(t, supported, SetLevel(AVX512))
+	assert.Equal(t, supported, Features())
+
+	for _, tier := range tiers {
+		if tier <= supported {
+			assert.Equal(t, tier, SetLevel(tier))
+			assert.Equal(t, tier >= SSE, sse)
+			assert.Equal(t, tier >= AVX2, avx2)
+			assert.Equal(t, tier >= AVX512, avx512)
+			assert.Equal(t, tier >= AVX2 && fma3, fma)
+			assert.Equal(t, tier >= AVX512 && avx512vbmi2, vbmi2)
+		}
+	}
+}
+
+func TestFMALevel(t *testing.T) {
+	// The exact product is 1-2^-46, which float32 rounds to 1, so only a fused multiply-add keeps it
+	input1, input2, input3 := []float32{1 + 0x1p-23}, []float32{1 - 0x1p-23}, []float32{-1}
+	rangeTiers(t, func(t *testing.T) {
+		switch {
+		case Features() == SSE:
+			assert.Equal(t, []float32{0}, FMA(make([]float32, 1), input1, input2, input3))
+			assert.Equal(t, []float32{0}, AXPY(make([]float32, 1), input1[0], input2, input3))
+		case Features() >= AVX2 && fma3:
+			assert.Equal(t, []float32{-0x1p-46}, FMA(make([]float32, 1), input1, input2, input3))
+			assert.Equal(t, []float32{-0x1p-46}, AXPY(make([]float32, 1), input1[0], input2, input3))
+		}
+	})
+}
+
+func TestLevelString(t *testing.T) {
+	for _, tier := range tiers {
+		level, ok := parseLevel(tier.String())
+		assert.True(t, ok)
+		assert.Equal(t, tier, level)
+	}
+
+	_, ok := parseLevel("neon")
+	assert.False(t, ok)
+}
+
+func TestDisabled(t *testing.T) {
+	for value, expect := range map[string]Level{
+		"":       AVX512,
+		"0":      AVX512,
+		"false":  AVX512,
+		"1":      Generic,
+		"true":   Generic,
+		"sse":    Generic,
+		"avx2":   SSE,
+		"AVX512": AVX2,
+	} {
+		level, err := disabled(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expect, level, value)
+	}
+
+	// Unknown values, and generic code which can't be turned off, leave everything enabled
+	for _, value := range []string{"generic", "avx-512", "neon", "yes please"} {
+		level, err := disabled(value)
+		assert.Error(t, err, value)
+		assert.Equal(t, AVX512, level, value)
+	}
+}
+
+func TestNoAllocs(t *testing.T) {
+	rangeModes(func(mode string) {
+		var input, output [16]float32
+		assert.Zero(t, testing.AllocsPerRun(100, func() {
+			SumFloat32s(input[:])
+			MinMaxFloat32s(input[:])
+			ArgMaxFloat32s(input[:])
+			DotFloat32s(input[:], input[:])
+			SumFloat32sKahan(input[:])
+			SumFloat32sPairwise(input[:])
+			AddFloat32s(output[:], input[:], input[:])
+		}), mode)
+	})
+}